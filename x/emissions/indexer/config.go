@@ -0,0 +1,69 @@
+package indexer
+
+import (
+	"fmt"
+
+	"cosmossdk.io/log"
+)
+
+// Config is the [emissions.indexer] section of app.toml.
+type Config struct {
+	// Enabled turns the indexer on. When false, NewIndexer always uses a NoopSink.
+	Enabled bool `mapstructure:"enabled"`
+	// Driver selects the Sink implementation. Currently only "postgres" is supported.
+	Driver string `mapstructure:"driver"`
+	// DSN is the driver-specific connection string, e.g. a Postgres connection URL.
+	DSN string `mapstructure:"dsn"`
+	// BatchSize is the number of records accumulated per table before a flush.
+	BatchSize int `mapstructure:"batch_size"`
+	// BufferSize is the number of pending events Indexer buffers before dropping them.
+	BufferSize int `mapstructure:"buffer_size"`
+}
+
+// DefaultConfig returns the indexer disabled, so that nodes which don't
+// configure [emissions.indexer] in app.toml pay no overhead.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:    false,
+		Driver:     "postgres",
+		BatchSize:  100,
+		BufferSize: 4096,
+	}
+}
+
+// NewIndexer builds an Indexer from Config, wiring in the appropriate Sink.
+// If the indexer is disabled, it returns an Indexer backed by NoopSink so
+// that callers can unconditionally call Start/Stop/Emit* without checking
+// cfg.Enabled themselves.
+func NewIndexer(cfg Config, logger log.Logger) (*Indexer, error) {
+	sink, err := newSink(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build emissions indexer sink: %w", err)
+	}
+	return New(sink, logger, cfg.BufferSize, cfg.BatchSize), nil
+}
+
+func newSink(cfg Config) (Sink, error) {
+	if !cfg.Enabled {
+		return NoopSink{}, nil
+	}
+	factory, ok := drivers[cfg.Driver]
+	if !ok {
+		return nil, fmt.Errorf("unsupported emissions indexer driver %q (forgot a blank import of its package?)", cfg.Driver)
+	}
+	return factory(cfg.DSN)
+}
+
+// DriverFactory builds a Sink from a driver-specific DSN.
+type DriverFactory func(dsn string) (Sink, error)
+
+var drivers = map[string]DriverFactory{}
+
+// RegisterDriver makes a Sink implementation available under name for use in
+// [emissions.indexer] driver config. It is intended to be called from a
+// driver package's init() function, mirroring the database/sql driver
+// registry pattern, so the core indexer package never needs to import a
+// concrete backend like Postgres directly.
+func RegisterDriver(name string, factory DriverFactory) {
+	drivers[name] = factory
+}
@@ -0,0 +1,224 @@
+// Package indexer streams structured records for reward events produced
+// during EmitRewards to a pluggable analytics sink (see Sink), so operators
+// get a first-class source of truth for topic/participant rewards without
+// having to re-derive them from raw txs and events.
+package indexer
+
+import (
+	"sync"
+
+	"cosmossdk.io/log"
+)
+
+// TopicRewardRecord mirrors one row of the topic_rewards analytics table:
+// the weight and reward a topic was allotted for a block, alongside the fee
+// revenue that backed it.
+type TopicRewardRecord struct {
+	BlockHeight int64
+	TopicId     uint64
+	Weight      string
+	Reward      string
+	FeeRevenue  string
+}
+
+// ParticipantRewardRecord mirrors one row of the participant_rewards
+// analytics table: a single payout to a reputer, inferer, or forecaster.
+type ParticipantRewardRecord struct {
+	BlockHeight int64
+	TopicId     uint64
+	Address     string
+	Type        string
+	Amount      string
+}
+
+// TopicInactivationRecord mirrors one row of the topic_inactivations
+// analytics table: a topic whose weight fell below the governance-set
+// minimum and was excluded from this epoch's churn.
+type TopicInactivationRecord struct {
+	BlockHeight int64
+	TopicId     uint64
+	Weight      string
+	MinWeight   string
+}
+
+// Sink is a pluggable analytics backend for indexed reward records. A Sink
+// implementation must tolerate being called with records it has already
+// seen (e.g. after a replay) and treat writes as idempotent, since Indexer
+// makes no delivery guarantee beyond best-effort.
+type Sink interface {
+	WriteTopicRewards(records []TopicRewardRecord) error
+	WriteParticipantRewards(records []ParticipantRewardRecord) error
+	WriteTopicInactivations(records []TopicInactivationRecord) error
+	Close() error
+}
+
+// event is the internal tagged union pushed through Indexer's channel.
+type event struct {
+	topicReward       *TopicRewardRecord
+	participantReward *ParticipantRewardRecord
+	topicInactivation *TopicInactivationRecord
+}
+
+// Indexer batches reward events emitted during EmitRewards and flushes them
+// to a Sink from a single background goroutine, off the consensus-critical
+// path: EmitTopic*/EmitParticipant* never block, and a full buffer results in
+// a dropped record and a logged warning rather than backpressure on EndBlock.
+// A nil *Indexer is valid and every method becomes a no-op, so call sites
+// don't need to branch on whether indexing is enabled.
+type Indexer struct {
+	sink      Sink
+	batchSize int
+	logger    log.Logger
+
+	events chan event
+	done   chan struct{}
+	wg     sync.WaitGroup
+}
+
+// New constructs an Indexer that batches up to batchSize records per table
+// before flushing, buffering up to bufferSize pending events before it
+// starts dropping. Call Start to begin processing and Stop to flush and
+// shut down cleanly.
+func New(sink Sink, logger log.Logger, bufferSize int, batchSize int) *Indexer {
+	if batchSize < 1 {
+		batchSize = 1
+	}
+	return &Indexer{
+		sink:      sink,
+		batchSize: batchSize,
+		logger:    logger.With("module", "emissions/indexer"),
+		events:    make(chan event, bufferSize),
+		done:      make(chan struct{}),
+	}
+}
+
+// Start launches the background flush loop. It must be called once, before
+// any Emit* calls are made.
+func (idx *Indexer) Start() {
+	if idx == nil {
+		return
+	}
+	idx.wg.Add(1)
+	go idx.run()
+}
+
+// Stop drains and flushes any buffered events, closes the sink, and blocks
+// until the background goroutine has exited.
+func (idx *Indexer) Stop() {
+	if idx == nil {
+		return
+	}
+	close(idx.done)
+	idx.wg.Wait()
+	if err := idx.sink.Close(); err != nil {
+		idx.logger.Error("failed to close indexer sink", "err", err)
+	}
+}
+
+// EmitTopicReward records a topic's finalized weight/reward/fee-revenue for
+// the current block. Never blocks; drops and logs on a full buffer.
+func (idx *Indexer) EmitTopicReward(record TopicRewardRecord) {
+	if idx == nil {
+		return
+	}
+	idx.send(event{topicReward: &record})
+}
+
+// EmitParticipantReward records a single payout to a reputer, inferer, or
+// forecaster. Never blocks; drops and logs on a full buffer.
+func (idx *Indexer) EmitParticipantReward(record ParticipantRewardRecord) {
+	if idx == nil {
+		return
+	}
+	idx.send(event{participantReward: &record})
+}
+
+// EmitTopicInactivation records a topic falling below the minimum weight and
+// being excluded from this epoch's churn. Never blocks; drops and logs on a
+// full buffer.
+func (idx *Indexer) EmitTopicInactivation(record TopicInactivationRecord) {
+	if idx == nil {
+		return
+	}
+	idx.send(event{topicInactivation: &record})
+}
+
+func (idx *Indexer) send(e event) {
+	select {
+	case idx.events <- e:
+	default:
+		idx.logger.Warn("indexer buffer full, dropping reward event")
+	}
+}
+
+func (idx *Indexer) run() {
+	defer idx.wg.Done()
+
+	var topicRewards []TopicRewardRecord
+	var participantRewards []ParticipantRewardRecord
+	var topicInactivations []TopicInactivationRecord
+
+	flush := func() {
+		if len(topicRewards) > 0 {
+			if err := idx.sink.WriteTopicRewards(topicRewards); err != nil {
+				idx.logger.Error("failed to write topic reward records", "err", err)
+			}
+			topicRewards = nil
+		}
+		if len(participantRewards) > 0 {
+			if err := idx.sink.WriteParticipantRewards(participantRewards); err != nil {
+				idx.logger.Error("failed to write participant reward records", "err", err)
+			}
+			participantRewards = nil
+		}
+		if len(topicInactivations) > 0 {
+			if err := idx.sink.WriteTopicInactivations(topicInactivations); err != nil {
+				idx.logger.Error("failed to write topic inactivation records", "err", err)
+			}
+			topicInactivations = nil
+		}
+	}
+
+	for {
+		select {
+		case e := <-idx.events:
+			switch {
+			case e.topicReward != nil:
+				topicRewards = append(topicRewards, *e.topicReward)
+				if len(topicRewards) >= idx.batchSize {
+					flush()
+				}
+			case e.participantReward != nil:
+				participantRewards = append(participantRewards, *e.participantReward)
+				if len(participantRewards) >= idx.batchSize {
+					flush()
+				}
+			case e.topicInactivation != nil:
+				topicInactivations = append(topicInactivations, *e.topicInactivation)
+				if len(topicInactivations) >= idx.batchSize {
+					flush()
+				}
+			}
+		case <-idx.done:
+			// Drain whatever is already buffered, then do a final flush.
+			for {
+				select {
+				case e := <-idx.events:
+					switch {
+					case e.topicReward != nil:
+						topicRewards = append(topicRewards, *e.topicReward)
+					case e.participantReward != nil:
+						participantRewards = append(participantRewards, *e.participantReward)
+					case e.topicInactivation != nil:
+						topicInactivations = append(topicInactivations, *e.topicInactivation)
+					}
+					continue
+				default:
+				}
+				break
+			}
+			flush()
+			return
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package indexer
+
+import (
+	"sync"
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSink struct {
+	mu                 sync.Mutex
+	topicRewards       []TopicRewardRecord
+	participantRewards []ParticipantRewardRecord
+	topicInactivations []TopicInactivationRecord
+	closed             bool
+}
+
+func (f *fakeSink) WriteTopicRewards(records []TopicRewardRecord) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.topicRewards = append(f.topicRewards, records...)
+	return nil
+}
+
+func (f *fakeSink) WriteParticipantRewards(records []ParticipantRewardRecord) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.participantRewards = append(f.participantRewards, records...)
+	return nil
+}
+
+func (f *fakeSink) WriteTopicInactivations(records []TopicInactivationRecord) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.topicInactivations = append(f.topicInactivations, records...)
+	return nil
+}
+
+func (f *fakeSink) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.closed = true
+	return nil
+}
+
+func TestIndexerFlushesOnStop(t *testing.T) {
+	sink := &fakeSink{}
+	idx := New(sink, log.NewNopLogger(), 16, 100)
+	idx.Start()
+
+	idx.EmitTopicReward(TopicRewardRecord{TopicId: 1, Reward: "10"})
+	idx.EmitParticipantReward(ParticipantRewardRecord{TopicId: 1, Address: "addr", Amount: "10"})
+	idx.EmitTopicInactivation(TopicInactivationRecord{TopicId: 2})
+
+	idx.Stop()
+
+	sink.mu.Lock()
+	defer sink.mu.Unlock()
+	require.Len(t, sink.topicRewards, 1)
+	require.Len(t, sink.participantRewards, 1)
+	require.Len(t, sink.topicInactivations, 1)
+	require.True(t, sink.closed)
+}
+
+func TestNilIndexerIsNoop(t *testing.T) {
+	var idx *Indexer
+	require.NotPanics(t, func() {
+		idx.Start()
+		idx.EmitTopicReward(TopicRewardRecord{})
+		idx.Stop()
+	})
+}
@@ -0,0 +1,11 @@
+package indexer
+
+// NoopSink discards every record written to it. It's the default Sink when
+// [emissions.indexer] is disabled or unconfigured in app.toml, so that
+// building an Indexer is always safe regardless of node configuration.
+type NoopSink struct{}
+
+func (NoopSink) WriteTopicRewards(_ []TopicRewardRecord) error             { return nil }
+func (NoopSink) WriteParticipantRewards(_ []ParticipantRewardRecord) error { return nil }
+func (NoopSink) WriteTopicInactivations(_ []TopicInactivationRecord) error { return nil }
+func (NoopSink) Close() error                                              { return nil }
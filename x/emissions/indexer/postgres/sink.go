@@ -0,0 +1,133 @@
+// Package postgres implements the emissions indexer's default Sink,
+// persisting reward records to a Postgres database for downstream analytics.
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	_ "github.com/lib/pq"
+
+	"github.com/allora-network/allora-chain/x/emissions/indexer"
+)
+
+func init() {
+	indexer.RegisterDriver("postgres", func(dsn string) (indexer.Sink, error) {
+		return NewSink(dsn)
+	})
+}
+
+const schema = `
+CREATE TABLE IF NOT EXISTS topic_rewards (
+	block_height BIGINT NOT NULL,
+	topic_id     BIGINT NOT NULL,
+	weight       TEXT   NOT NULL,
+	reward       TEXT   NOT NULL,
+	fee_revenue  TEXT   NOT NULL,
+	PRIMARY KEY (block_height, topic_id)
+);
+CREATE TABLE IF NOT EXISTS participant_rewards (
+	block_height BIGINT NOT NULL,
+	topic_id     BIGINT NOT NULL,
+	address      TEXT   NOT NULL,
+	type         TEXT   NOT NULL,
+	amount       TEXT   NOT NULL,
+	PRIMARY KEY (block_height, topic_id, address, type)
+);
+CREATE TABLE IF NOT EXISTS topic_inactivations (
+	block_height BIGINT NOT NULL,
+	topic_id     BIGINT NOT NULL,
+	weight       TEXT   NOT NULL,
+	min_weight   TEXT   NOT NULL,
+	PRIMARY KEY (block_height, topic_id)
+);
+`
+
+// Sink persists emissions indexer records to Postgres. Every table is keyed
+// on (block_height, topic_id, ...) so that replaying the same block's events
+// (e.g. after a crash before the buffer fully drained) is idempotent.
+type Sink struct {
+	db *sql.DB
+}
+
+// NewSink opens a connection pool to dsn and ensures the indexer's tables exist.
+func NewSink(dsn string) (*Sink, error) {
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open postgres connection: %w", err)
+	}
+	if err := db.Ping(); err != nil {
+		return nil, fmt.Errorf("failed to ping postgres: %w", err)
+	}
+	if _, err := db.Exec(schema); err != nil {
+		return nil, fmt.Errorf("failed to ensure emissions indexer schema: %w", err)
+	}
+	return &Sink{db: db}, nil
+}
+
+func (s *Sink) WriteTopicRewards(records []indexer.TopicRewardRecord) error {
+	return s.batchInsert(
+		`INSERT INTO topic_rewards (block_height, topic_id, weight, reward, fee_revenue) VALUES %s
+		 ON CONFLICT (block_height, topic_id) DO NOTHING`,
+		5,
+		len(records),
+		func(i int) []any {
+			r := records[i]
+			return []any{r.BlockHeight, r.TopicId, r.Weight, r.Reward, r.FeeRevenue}
+		},
+	)
+}
+
+func (s *Sink) WriteParticipantRewards(records []indexer.ParticipantRewardRecord) error {
+	return s.batchInsert(
+		`INSERT INTO participant_rewards (block_height, topic_id, address, type, amount) VALUES %s
+		 ON CONFLICT (block_height, topic_id, address, type) DO NOTHING`,
+		5,
+		len(records),
+		func(i int) []any {
+			r := records[i]
+			return []any{r.BlockHeight, r.TopicId, r.Address, r.Type, r.Amount}
+		},
+	)
+}
+
+func (s *Sink) WriteTopicInactivations(records []indexer.TopicInactivationRecord) error {
+	return s.batchInsert(
+		`INSERT INTO topic_inactivations (block_height, topic_id, weight, min_weight) VALUES %s
+		 ON CONFLICT (block_height, topic_id) DO NOTHING`,
+		4,
+		len(records),
+		func(i int) []any {
+			r := records[i]
+			return []any{r.BlockHeight, r.TopicId, r.Weight, r.MinWeight}
+		},
+	)
+}
+
+func (s *Sink) Close() error {
+	return s.db.Close()
+}
+
+// batchInsert executes queryTemplate (a single INSERT with a "VALUES %s"
+// placeholder) against count rows of width columnsPerRow, built from row(i).
+func (s *Sink) batchInsert(queryTemplate string, columnsPerRow, count int, row func(i int) []any) error {
+	if count == 0 {
+		return nil
+	}
+
+	valuePlaceholders := make([]string, count)
+	args := make([]any, 0, count*columnsPerRow)
+	for i := 0; i < count; i++ {
+		placeholders := make([]string, columnsPerRow)
+		for c := 0; c < columnsPerRow; c++ {
+			placeholders[c] = fmt.Sprintf("$%d", i*columnsPerRow+c+1)
+		}
+		valuePlaceholders[i] = "(" + strings.Join(placeholders, ", ") + ")"
+		args = append(args, row(i)...)
+	}
+
+	query := fmt.Sprintf(queryTemplate, strings.Join(valuePlaceholders, ", "))
+	_, err := s.db.Exec(query, args...)
+	return err
+}
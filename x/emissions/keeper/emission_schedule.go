@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetCumSumBaseline returns the running total of scheduled baseline emission
+// (as of the last block in which EmitRewards ran) used by the
+// baseline-vs-realized emission schedule.
+func (k Keeper) GetCumSumBaseline(ctx sdk.Context) (alloraMath.Dec, error) {
+	cumSumBaseline, err := k.cumSumBaseline.Get(ctx)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return alloraMath.ZeroDec(), nil
+		}
+		return alloraMath.Dec{}, err
+	}
+	return cumSumBaseline, nil
+}
+
+// SetCumSumBaseline overwrites the running total of scheduled baseline emission.
+func (k Keeper) SetCumSumBaseline(ctx sdk.Context, cumSumBaseline alloraMath.Dec) error {
+	return k.cumSumBaseline.Set(ctx, cumSumBaseline)
+}
+
+// GetCumSumRealized returns the running total of rewards actually distributed
+// to topics (as opposed to scheduled) since baseline-emission schedule
+// tracking began (see GetScheduleGenesisHeight).
+func (k Keeper) GetCumSumRealized(ctx sdk.Context) (alloraMath.Dec, error) {
+	cumSumRealized, err := k.cumSumRealized.Get(ctx)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return alloraMath.ZeroDec(), nil
+		}
+		return alloraMath.Dec{}, err
+	}
+	return cumSumRealized, nil
+}
+
+// SetCumSumRealized overwrites the running total of realized rewards.
+func (k Keeper) SetCumSumRealized(ctx sdk.Context, cumSumRealized alloraMath.Dec) error {
+	return k.cumSumRealized.Set(ctx, cumSumRealized)
+}
+
+// GetScheduleGenesisHeight returns the block height at which baseline-emission
+// schedule tracking began, initializing it to the current block height the
+// first time it's called. EmitRewards uses blockHeight-GetScheduleGenesisHeight
+// (not blockHeight directly) as CumSumBaselineAtTime's t, so the schedule
+// clock starts from zero when the feature activates - whether that's chain
+// genesis or a later upgrade height - instead of back-integrating the curve
+// from height 0 and reporting a huge CumSumBaseline on the very first
+// post-upgrade block.
+func (k Keeper) GetScheduleGenesisHeight(ctx sdk.Context) (int64, error) {
+	height, err := k.scheduleGenesisHeight.Get(ctx)
+	if err == nil {
+		return height, nil
+	}
+	if !errors.IsOf(err, collections.ErrNotFound) {
+		return 0, err
+	}
+	height = ctx.BlockHeight()
+	if err := k.scheduleGenesisHeight.Set(ctx, height); err != nil {
+		return 0, err
+	}
+	return height, nil
+}
+
+// GetEffectiveNetworkTime returns the network's current position along the
+// baseline emission curve. It advances only as realized rewards catch up to
+// the scheduled baseline, so it can lag wall-clock/block time during
+// low-activity epochs.
+func (k Keeper) GetEffectiveNetworkTime(ctx sdk.Context) (alloraMath.Dec, error) {
+	effectiveNetworkTime, err := k.effectiveNetworkTime.Get(ctx)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return alloraMath.ZeroDec(), nil
+		}
+		return alloraMath.Dec{}, err
+	}
+	return effectiveNetworkTime, nil
+}
+
+// SetEffectiveNetworkTime overwrites the network's current position along the
+// baseline emission curve.
+func (k Keeper) SetEffectiveNetworkTime(ctx sdk.Context, effectiveNetworkTime alloraMath.Dec) error {
+	return k.effectiveNetworkTime.Set(ctx, effectiveNetworkTime)
+}
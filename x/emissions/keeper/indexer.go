@@ -0,0 +1,13 @@
+package keeper
+
+import (
+	"github.com/allora-network/allora-chain/x/emissions/indexer"
+)
+
+// Indexer returns the keeper's configured reward-events indexer. It is never
+// nil at runtime (NewIndexer always returns a usable Indexer, backed by
+// NoopSink when [emissions.indexer] is disabled), but a nil *indexer.Indexer
+// is also safe to call into, so test keepers may leave it unset.
+func (k Keeper) Indexer() *indexer.Indexer {
+	return k.indexer
+}
@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/core/store"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/indexer"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BankKeeper is the subset of x/bank's keeper the emissions module needs to
+// move coins between modules and accounts during reward payout.
+type BankKeeper interface {
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+}
+
+// Keeper holds the state introduced by the reward-pipeline work in
+// x/emissions/module/rewards: the emission schedule's running sums, the
+// per-topic reward-weight smoothing filters, skipped-topic refund records,
+// and the reward-events indexer. This composes with the module's broader
+// keeper surface (topic/score/stake state, etc.), which is out of scope here.
+type Keeper struct {
+	cdc        codec.BinaryCodec
+	authority  string
+	bankKeeper BankKeeper
+	schema     collections.Schema
+
+	params collections.Item[types.Params]
+
+	cumSumBaseline       collections.Item[alloraMath.Dec]
+	cumSumRealized       collections.Item[alloraMath.Dec]
+	effectiveNetworkTime collections.Item[alloraMath.Dec]
+
+	// scheduleGenesisHeight is the block height at which baseline-emission
+	// schedule tracking began, so CumSumBaselineAtTime integrates from t=0 at
+	// activation rather than from chain genesis (see GetScheduleGenesisHeight).
+	scheduleGenesisHeight collections.Item[int64]
+
+	topicFilterEstimates collections.Map[uint64, types.FilterEstimate]
+
+	skippedTopicRefunds collections.Map[collections.Pair[uint64, int64], types.SkippedTopicRefund]
+
+	indexer *indexer.Indexer
+}
+
+// NewKeeper builds a Keeper, registering every collection declared above
+// against a single collections.SchemaBuilder so key-prefix collisions are
+// caught at startup rather than silently corrupting state.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService storetypes.KVStoreService,
+	authority string,
+	bankKeeper BankKeeper,
+	idx *indexer.Indexer,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+
+	k := Keeper{
+		cdc:        cdc,
+		authority:  authority,
+		bankKeeper: bankKeeper,
+		indexer:    idx,
+
+		params: collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+
+		cumSumBaseline:       collections.NewItem(sb, types.CumSumBaselineKey, "cum_sum_baseline", alloraMath.DecValue),
+		cumSumRealized:       collections.NewItem(sb, types.CumSumRealizedKey, "cum_sum_realized", alloraMath.DecValue),
+		effectiveNetworkTime: collections.NewItem(sb, types.EffectiveNetworkTimeKey, "effective_network_time", alloraMath.DecValue),
+
+		scheduleGenesisHeight: collections.NewItem(sb, types.ScheduleGenesisHeightKey, "schedule_genesis_height", collections.Int64Value),
+
+		topicFilterEstimates: collections.NewMap(
+			sb, types.TopicFilterEstimatesKey, "topic_filter_estimates",
+			collections.Uint64Key, codec.CollValue[types.FilterEstimate](cdc),
+		),
+
+		skippedTopicRefunds: collections.NewMap(
+			sb, types.SkippedTopicRefundsKey, "skipped_topic_refunds",
+			collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key),
+			codec.CollValue[types.SkippedTopicRefund](cdc),
+		),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.schema = schema
+
+	// Indexer.Start must run once before any EmitTopic*/EmitParticipant* call;
+	// NewKeeper is the one place guaranteed to run exactly once per process,
+	// so it owns starting the background flush loop. The matching Stop lives
+	// on Close, for the app to call during graceful shutdown.
+	idx.Start()
+
+	return k
+}
+
+// Close flushes and shuts down the keeper's indexer. The app should call this
+// once, during graceful shutdown, after the last EndBlock has run.
+func (k Keeper) Close() {
+	k.indexer.Stop()
+}
+
+// GetAuthority returns the account (typically the governance module) allowed
+// to submit authority-gated messages like MsgAcknowledgeSkippedRefund.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// BankKeeper returns the keeper's bank module dependency.
+func (k Keeper) BankKeeper() BankKeeper {
+	return k.bankKeeper
+}
+
+// GetParams returns the module's current parameter set.
+func (k Keeper) GetParams(ctx sdk.Context) (types.Params, error) {
+	return k.params.Get(ctx)
+}
+
+// SetParams overwrites the module's parameter set, validating it first.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	return k.params.Set(ctx, params)
+}
@@ -0,0 +1,30 @@
+package msgserver
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// AcknowledgeSkippedRefund implements the Msg/AcknowledgeSkippedRefund gRPC
+// method. It is governance-gated since acknowledging a refund is an
+// operational bookkeeping action, not something any individual account should
+// be able to do on a topic's behalf.
+func (ms msgServer) AcknowledgeSkippedRefund(
+	ctx context.Context,
+	msg *types.MsgAcknowledgeSkippedRefund,
+) (*types.MsgAcknowledgeSkippedRefundResponse, error) {
+	if msg.Sender != ms.k.GetAuthority() {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.k.GetAuthority(), msg.Sender)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := ms.k.AcknowledgeSkippedTopicRefund(sdkCtx, msg.TopicId, msg.BlockHeight); err != nil {
+		return nil, errors.Wrapf(err, "failed to acknowledge skipped topic refund for topic %d", msg.TopicId)
+	}
+
+	return &types.MsgAcknowledgeSkippedRefundResponse{}, nil
+}
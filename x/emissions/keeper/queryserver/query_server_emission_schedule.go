@@ -0,0 +1,38 @@
+package queryserver
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EmissionScheduleState implements the Query/EmissionScheduleState gRPC query,
+// exposing the baseline-vs-realized emission counters maintained in
+// rewards.EmitRewards for observability.
+func (qs queryServer) EmissionScheduleState(
+	ctx context.Context,
+	req *types.QueryEmissionScheduleStateRequest,
+) (*types.QueryEmissionScheduleStateResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	cumSumBaseline, err := qs.k.GetCumSumBaseline(sdkCtx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get cumulative baseline emission")
+	}
+	cumSumRealized, err := qs.k.GetCumSumRealized(sdkCtx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get cumulative realized emission")
+	}
+	effectiveNetworkTime, err := qs.k.GetEffectiveNetworkTime(sdkCtx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get effective network time")
+	}
+
+	return &types.QueryEmissionScheduleStateResponse{
+		CumSumBaseline:       cumSumBaseline.String(),
+		CumSumRealized:       cumSumRealized.String(),
+		EffectiveNetworkTime: effectiveNetworkTime.String(),
+	}, nil
+}
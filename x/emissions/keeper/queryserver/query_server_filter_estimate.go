@@ -0,0 +1,33 @@
+package queryserver
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"github.com/allora-network/allora-chain/x/emissions/module/rewards"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FilterEstimate implements the Query/FilterEstimate gRPC query, letting
+// clients extrapolate a topic's smoothed reward weight at an arbitrary height
+// without waiting for the next block's observation.
+func (qs queryServer) FilterEstimate(
+	ctx context.Context,
+	req *types.QueryFilterEstimateRequest,
+) (*types.QueryFilterEstimateResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	estimate, err := qs.k.GetFilterEstimate(sdkCtx, req.TopicId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get filter estimate for topic %d", req.TopicId)
+	}
+	extrapolated, err := rewards.EstimateAt(estimate, req.Height)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to extrapolate filter estimate for topic %d", req.TopicId)
+	}
+
+	return &types.QueryFilterEstimateResponse{
+		Estimate: extrapolated.String(),
+	}, nil
+}
@@ -0,0 +1,28 @@
+package queryserver
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SkippedTopicRefunds implements the Query/SkippedTopicRefunds gRPC query,
+// letting clients audit the refunds EmitRewards issued for topics it had to
+// skip after their fee revenue was already swept into the reward pool.
+func (qs queryServer) SkippedTopicRefunds(
+	ctx context.Context,
+	req *types.QuerySkippedTopicRefundsRequest,
+) (*types.QuerySkippedTopicRefundsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	refunds, err := qs.k.GetSkippedTopicRefunds(sdkCtx, req.TopicId, req.FromHeight, req.ToHeight)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get skipped topic refunds for topic %d", req.TopicId)
+	}
+
+	return &types.QuerySkippedTopicRefundsResponse{
+		Refunds: refunds,
+	}, nil
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetFilterEstimate returns the current alpha-beta filter state tracking a
+// topic's smoothed reward weight (or, for GlobalFilterEstimateTopicId, the
+// network-wide smoothed weight sum). A never-before-seen topic id returns the
+// zero-value estimate, which UpdateFilterEstimate treats as an uninitialized
+// filter to be seeded from the first observation.
+func (k Keeper) GetFilterEstimate(ctx sdk.Context, topicId uint64) (types.FilterEstimate, error) {
+	estimate, err := k.topicFilterEstimates.Get(ctx, topicId)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return types.FilterEstimate{}, nil
+		}
+		return types.FilterEstimate{}, err
+	}
+	return estimate, nil
+}
+
+// SetFilterEstimate overwrites the alpha-beta filter state for a topic (or the
+// network-wide estimate, for GlobalFilterEstimateTopicId).
+func (k Keeper) SetFilterEstimate(ctx sdk.Context, topicId uint64, estimate types.FilterEstimate) error {
+	return k.topicFilterEstimates.Set(ctx, topicId, estimate)
+}
+
+// GetParamsRewardSmoothingFactors returns the governance-set alpha and beta
+// gains for the reward weight alpha-beta filter.
+func (k Keeper) GetParamsRewardSmoothingFactors(ctx sdk.Context) (alpha alloraMath.Dec, beta alloraMath.Dec, err error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return alloraMath.Dec{}, alloraMath.Dec{}, err
+	}
+	return params.RewardSmoothingAlpha, params.RewardSmoothingBeta, nil
+}
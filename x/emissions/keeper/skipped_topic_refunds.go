@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RecreditTopicFeeRevenue adds amount back onto a topic's current-epoch fee
+// revenue, the inverse of the debit ResetTopicFeeRevenue performs once a
+// topic's revenue has been swept into the reward pool. It's used to undo that
+// sweep when a topic is skipped after the sweep already happened.
+func (k Keeper) RecreditTopicFeeRevenue(ctx sdk.Context, topicId TopicId, amount cosmosMath.Int) error {
+	topicFeeRevenue, err := k.GetTopicFeeRevenue(ctx, topicId)
+	if err != nil {
+		return err
+	}
+	topicFeeRevenue.Revenue = topicFeeRevenue.Revenue.Add(amount)
+	return k.topicFeeRevenue.Set(ctx, topicId, topicFeeRevenue)
+}
+
+// SetSkippedTopicRefund records a skipped-topic refund, keyed by topic id and
+// block height, for later inspection via the SkippedTopicRefunds query and
+// cleanup via MsgAcknowledgeSkippedRefund.
+func (k Keeper) SetSkippedTopicRefund(ctx sdk.Context, refund types.SkippedTopicRefund) error {
+	key := collections.Join(refund.TopicId, refund.BlockHeight)
+	return k.skippedTopicRefunds.Set(ctx, key, refund)
+}
+
+// GetSkippedTopicRefunds returns every recorded refund for topicId with a
+// block height in [fromHeight, toHeight].
+func (k Keeper) GetSkippedTopicRefunds(ctx sdk.Context, topicId TopicId, fromHeight, toHeight int64) ([]types.SkippedTopicRefund, error) {
+	rng := collections.NewPrefixedPairRange[uint64, int64](topicId)
+
+	var refunds []types.SkippedTopicRefund
+	iter, err := k.skippedTopicRefunds.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		refund, err := iter.Value()
+		if err != nil {
+			return nil, err
+		}
+		if refund.BlockHeight < fromHeight || refund.BlockHeight > toHeight {
+			continue
+		}
+		refunds = append(refunds, refund)
+	}
+	return refunds, nil
+}
+
+// AcknowledgeSkippedTopicRefund marks a previously recorded refund as
+// acknowledged, so operators can distinguish refunds they've already
+// reconciled from ones still pending review.
+func (k Keeper) AcknowledgeSkippedTopicRefund(ctx sdk.Context, topicId TopicId, blockHeight int64) error {
+	key := collections.Join(topicId, blockHeight)
+	refund, err := k.skippedTopicRefunds.Get(ctx, key)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	refund.Acknowledged = true
+	return k.skippedTopicRefunds.Set(ctx, key, refund)
+}
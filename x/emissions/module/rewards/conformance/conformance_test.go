@@ -0,0 +1,79 @@
+package conformance
+
+import (
+	"flag"
+	"testing"
+
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/stretchr/testify/require"
+)
+
+// decimalTolerance bounds the acceptable rounding drift between a vector's
+// expected decimal strings and freshly computed ones, since Dec arithmetic
+// ordering can differ by a few ULPs across otherwise-equivalent implementations.
+const decimalTolerance = "0.0000000000000001"
+
+var regen = flag.Bool("regen", false, "recompute conformance vectors and overwrite their expected outputs instead of verifying them")
+
+func TestConformance(t *testing.T) {
+	if ShouldSkip() {
+		t.Skip("SKIP_CONFORMANCE is set")
+	}
+
+	dir := VectorsDir()
+	loaded, err := LoadVectors(dir)
+	if err != nil {
+		t.Skipf("no conformance vector corpus at %q (set %s to point elsewhere): %v", dir, vectorsDirEnvVar, err)
+	}
+	if len(loaded) == 0 {
+		t.Skipf("no conformance vectors found in %q", dir)
+	}
+
+	for _, entry := range loaded {
+		entry := entry
+		t.Run(entry.Vector.Name, func(t *testing.T) {
+			actual, err := RunVector(entry.Vector)
+			require.NoError(t, err)
+
+			if *regen {
+				regenVector(t, entry.Path, entry.Vector, actual)
+				return
+			}
+
+			verifyVector(t, entry.Vector, actual)
+		})
+	}
+}
+
+func verifyVector(t *testing.T, v TestVector, actual ActualOutputs) {
+	requireDecEqual(t, "task_reputer_reward", v.ExpectedTaskReputerReward, actual.TaskReputerReward)
+	requireDecEqual(t, "task_inference_reward", v.ExpectedTaskInferenceReward, actual.TaskInferenceReward)
+	requireDecEqual(t, "task_forecasting_reward", v.ExpectedTaskForecastingReward, actual.TaskForecastingReward)
+
+	require.Equal(t, v.ExpectedInfererRewards, actual.InfererRewards, "inferer rewards mismatch")
+	require.Equal(t, v.ExpectedForecasterRewards, actual.ForecasterRewards, "forecaster rewards mismatch")
+}
+
+func requireDecEqual(t *testing.T, field string, expected string, actual alloraMath.Dec) {
+	t.Helper()
+	expectedDec, err := alloraMath.NewDecFromString(expected)
+	require.NoError(t, err, "failed to parse expected %s", field)
+
+	tolerance, err := alloraMath.NewDecFromString(decimalTolerance)
+	require.NoError(t, err)
+
+	diff, err := expectedDec.Sub(actual)
+	require.NoError(t, err)
+	require.True(t, diff.Abs().Lte(tolerance), "%s mismatch: expected %s, got %s", field, expected, actual.String())
+}
+
+func regenVector(t *testing.T, path string, v TestVector, actual ActualOutputs) {
+	t.Helper()
+	v.ExpectedTaskReputerReward = actual.TaskReputerReward.String()
+	v.ExpectedTaskInferenceReward = actual.TaskInferenceReward.String()
+	v.ExpectedTaskForecastingReward = actual.TaskForecastingReward.String()
+	v.ExpectedInfererRewards = actual.InfererRewards
+	v.ExpectedForecasterRewards = actual.ForecasterRewards
+
+	require.NoError(t, WriteVector(path, v))
+}
@@ -0,0 +1,143 @@
+package conformance
+
+import (
+	"fmt"
+
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/module/rewards"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ActualOutputs is everything RunVector computes from a TestVector, ready to
+// be compared against its Expected* fields (verify mode) or written back into
+// them (regen mode).
+type ActualOutputs struct {
+	TaskReputerReward     alloraMath.Dec
+	TaskInferenceReward   alloraMath.Dec
+	TaskForecastingReward alloraMath.Dec
+
+	InfererRewards    []ExpectedWorkerReward
+	ForecasterRewards []ExpectedWorkerReward
+}
+
+// RunVector drives the keeper-independent core of the reward pipeline -
+// GetRewardForReputerTaskInTopic, GetRewardForInferenceTaskInTopic,
+// GetRewardForForecastingTaskInTopic, and GetRewardPerWorker - against a
+// vector's inputs. It takes ReputerTaskEntropy / InferenceTaskEntropy /
+// ForecastingTaskEntropy from the vector as trusted fixture inputs rather than
+// recomputing them, since that requires a live keeper to read prior-epoch EMA
+// state - see the package doc for exactly what's missing from this tree to
+// do that, and to drive GenerateRewardsDistributionByTopicParticipant /
+// CalcTopicRewards directly.
+func RunVector(v TestVector) (ActualOutputs, error) {
+	topicReward, err := alloraMath.NewDecFromString(v.TopicReward)
+	if err != nil {
+		return ActualOutputs{}, fmt.Errorf("failed to parse topic_reward: %w", err)
+	}
+	reputerEntropy, err := alloraMath.NewDecFromString(v.ReputerTaskEntropy)
+	if err != nil {
+		return ActualOutputs{}, fmt.Errorf("failed to parse reputer_task_entropy: %w", err)
+	}
+	inferenceEntropy, err := alloraMath.NewDecFromString(v.InferenceTaskEntropy)
+	if err != nil {
+		return ActualOutputs{}, fmt.Errorf("failed to parse inference_task_entropy: %w", err)
+	}
+	forecastingEntropy, err := alloraMath.NewDecFromString(v.ForecastingTaskEntropy)
+	if err != nil {
+		return ActualOutputs{}, fmt.Errorf("failed to parse forecasting_task_entropy: %w", err)
+	}
+
+	taskReputerReward, err := rewards.GetRewardForReputerTaskInTopic(
+		inferenceEntropy,
+		forecastingEntropy,
+		reputerEntropy,
+		&topicReward,
+	)
+	if err != nil {
+		return ActualOutputs{}, fmt.Errorf("failed to compute task reputer reward: %w", err)
+	}
+
+	taskInferenceReward, err := rewards.GetRewardForInferenceTaskInTopic(
+		v.NetworkLossBundle.NaiveValue,
+		v.NetworkLossBundle.CombinedValue,
+		inferenceEntropy,
+		forecastingEntropy,
+		reputerEntropy,
+		&topicReward,
+		v.Params.SigmoidA,
+		v.Params.SigmoidB,
+	)
+	if err != nil {
+		return ActualOutputs{}, fmt.Errorf("failed to compute task inference reward: %w", err)
+	}
+
+	taskForecastingReward, err := rewards.GetRewardForForecastingTaskInTopic(
+		v.NetworkLossBundle.NaiveValue,
+		v.NetworkLossBundle.CombinedValue,
+		inferenceEntropy,
+		forecastingEntropy,
+		reputerEntropy,
+		&topicReward,
+		v.Params.SigmoidA,
+		v.Params.SigmoidB,
+	)
+	if err != nil {
+		return ActualOutputs{}, fmt.Errorf("failed to compute task forecasting reward: %w", err)
+	}
+
+	infererAddresses, infererFractions, err := addressesAndFractions(v.Inferers)
+	if err != nil {
+		return ActualOutputs{}, fmt.Errorf("failed to parse inferers: %w", err)
+	}
+	infererTaskRewards, err := rewards.GetRewardPerWorker(v.TopicId, rewards.WorkerInferenceRewardType, &taskInferenceReward, infererAddresses, infererFractions)
+	if err != nil {
+		return ActualOutputs{}, fmt.Errorf("failed to compute inferer rewards: %w", err)
+	}
+
+	forecasterAddresses, forecasterFractions, err := addressesAndFractions(v.Forecasters)
+	if err != nil {
+		return ActualOutputs{}, fmt.Errorf("failed to parse forecasters: %w", err)
+	}
+	forecasterTaskRewards, err := rewards.GetRewardPerWorker(v.TopicId, rewards.WorkerForecastRewardType, &taskForecastingReward, forecasterAddresses, forecasterFractions)
+	if err != nil {
+		return ActualOutputs{}, fmt.Errorf("failed to compute forecaster rewards: %w", err)
+	}
+
+	return ActualOutputs{
+		TaskReputerReward:     taskReputerReward,
+		TaskInferenceReward:   taskInferenceReward,
+		TaskForecastingReward: taskForecastingReward,
+		InfererRewards:        toExpectedWorkerRewards(infererTaskRewards),
+		ForecasterRewards:     toExpectedWorkerRewards(forecasterTaskRewards),
+	}, nil
+}
+
+func addressesAndFractions(participants []ParticipantFraction) ([]sdk.AccAddress, []*alloraMath.Dec, error) {
+	addresses := make([]sdk.AccAddress, len(participants))
+	fractions := make([]*alloraMath.Dec, len(participants))
+	for i, p := range participants {
+		address, err := sdk.AccAddressFromBech32(p.Address)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse address %q: %w", p.Address, err)
+		}
+		addresses[i] = address
+
+		fraction, err := alloraMath.NewDecFromString(p.RewardFraction)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse reward_fraction for %s: %w", p.Address, err)
+		}
+		fractions[i] = &fraction
+	}
+	return addresses, fractions, nil
+}
+
+func toExpectedWorkerRewards(taskRewards []rewards.TaskRewards) []ExpectedWorkerReward {
+	out := make([]ExpectedWorkerReward, len(taskRewards))
+	for i, tr := range taskRewards {
+		out[i] = ExpectedWorkerReward{
+			Address: tr.Address.String(),
+			Reward:  tr.Reward.String(),
+		}
+	}
+	return out
+}
@@ -0,0 +1,157 @@
+// Package conformance drives the keeper-independent entropy-to-payout-split
+// arithmetic in x/emissions/module/rewards (GetRewardForReputerTaskInTopic,
+// GetRewardForInferenceTaskInTopic, GetRewardForForecastingTaskInTopic, and
+// GetRewardPerWorker) against a corpus of JSON test vectors, giving alternate
+// implementations (or future refactors of that arithmetic) a stable,
+// implementation-agnostic way to check for bit-identical behavior - distinct
+// from, and complementary to, ordinary Go unit tests.
+//
+// It does not drive GenerateRewardsDistributionByTopicParticipant or
+// CalcTopicRewards, and can't yet: their call graph (GetReputersRewardFractions,
+// GetReputerTaskEntropy, GetInferenceTaskRewardFractions,
+// GetForecastingTaskRewardFractions, GetTopicRewardFraction, GetTopicReward,
+// Keeper.GetScoresKeeper, Keeper.GetNetworkLossBundleAtBlock, and the topic/stake
+// state those lean on) isn't implemented anywhere in this tree - a pre-existing
+// gap in x/emissions/module/rewards that predates and is independent of the
+// conformance-harness work here. A harness for those two functions needs that
+// keeper surface to exist first; this package drives everything that's
+// possible to exercise without it.
+package conformance
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// vectorsDirEnvVar, when set, overrides the default vector corpus location.
+const vectorsDirEnvVar = "EMISSIONS_TEST_VECTORS_DIR"
+
+// skipEnvVar, when set to a non-empty value, bypasses the conformance suite
+// entirely - e.g. for environments that don't vendor the vector corpus.
+const skipEnvVar = "SKIP_CONFORMANCE"
+
+// defaultVectorsDir is where the vector corpus is expected to live if
+// vectorsDirEnvVar is unset, conventionally checked out as a submodule or
+// sparse clone alongside the rest of the repo.
+const defaultVectorsDir = "extern/emissions-test-vectors"
+
+// ParticipantFraction is a single participant's address and the reward
+// fraction it was assigned for a task, as returned by e.g.
+// GetInferenceTaskRewardFractions.
+type ParticipantFraction struct {
+	Address        string `json:"address"`
+	RewardFraction string `json:"reward_fraction"`
+}
+
+// ExpectedWorkerReward is one golden entry of GetRewardPerWorker's output.
+type ExpectedWorkerReward struct {
+	Address string `json:"address"`
+	Reward  string `json:"reward"`
+}
+
+// TestVector is one conformance fixture. RunVector takes ReputerTaskEntropy /
+// InferenceTaskEntropy / ForecastingTaskEntropy as trusted fixture inputs
+// (computing them requires a live keeper's prior-epoch EMA state, which this
+// harness doesn't have) and checks the deterministic, keeper-independent
+// core of the reward math that turns entropy and reward fractions into
+// payouts.
+type TestVector struct {
+	Name string `json:"name"`
+
+	TopicId           uint64            `json:"topic_id"`
+	Params            types.Params      `json:"params"`
+	NetworkLossBundle types.ValueBundle `json:"network_loss_bundle"`
+
+	Inferers    []ParticipantFraction `json:"inferers"`
+	Forecasters []ParticipantFraction `json:"forecasters"`
+
+	TopicReward string `json:"topic_reward"`
+
+	ReputerTaskEntropy     string `json:"reputer_task_entropy"`
+	InferenceTaskEntropy   string `json:"inference_task_entropy"`
+	ForecastingTaskEntropy string `json:"forecasting_task_entropy"`
+
+	ExpectedTaskReputerReward     string `json:"expected_task_reputer_reward"`
+	ExpectedTaskInferenceReward   string `json:"expected_task_inference_reward"`
+	ExpectedTaskForecastingReward string `json:"expected_task_forecasting_reward"`
+
+	ExpectedInfererRewards    []ExpectedWorkerReward `json:"expected_inferer_rewards"`
+	ExpectedForecasterRewards []ExpectedWorkerReward `json:"expected_forecaster_rewards"`
+}
+
+// ShouldSkip reports whether the conformance suite should bypass entirely,
+// per the SKIP_CONFORMANCE escape hatch.
+func ShouldSkip() bool {
+	return os.Getenv(skipEnvVar) != ""
+}
+
+// VectorsDir resolves the vector corpus directory: EMISSIONS_TEST_VECTORS_DIR
+// if set, otherwise defaultVectorsDir relative to the working directory.
+func VectorsDir() string {
+	if dir := os.Getenv(vectorsDirEnvVar); dir != "" {
+		return dir
+	}
+	return defaultVectorsDir
+}
+
+// LoadedVector pairs a parsed TestVector with the path it was read from, so
+// --regen can write its recomputed outputs back to the same file.
+type LoadedVector struct {
+	Path   string
+	Vector TestVector
+}
+
+// LoadVectors reads every *.json file directly under dir as a TestVector,
+// sorted by filename for reproducible test output.
+func LoadVectors(dir string) ([]LoadedVector, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read conformance vectors dir %q: %w", dir, err)
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+		names = append(names, entry.Name())
+	}
+	sort.Strings(names)
+
+	loaded := make([]LoadedVector, 0, len(names))
+	for _, name := range names {
+		path := filepath.Join(dir, name)
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read conformance vector %q: %w", path, err)
+		}
+		var vector TestVector
+		if err := json.Unmarshal(data, &vector); err != nil {
+			return nil, fmt.Errorf("failed to parse conformance vector %q: %w", path, err)
+		}
+		if vector.Name == "" {
+			vector.Name = name
+		}
+		loaded = append(loaded, LoadedVector{Path: path, Vector: vector})
+	}
+
+	return loaded, nil
+}
+
+// WriteVector writes v back to path as indented JSON, for use by --regen.
+func WriteVector(path string, v TestVector) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal conformance vector %q: %w", path, err)
+	}
+	data = append(data, '\n')
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write conformance vector %q: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package rewards
+
+import (
+	"cosmossdk.io/errors"
+	alloraMath "github.com/allora-network/allora-chain/math"
+)
+
+// BaselineEmissionAtTime returns the instantaneous baseline emission rate at
+// effective network time t, following the Filecoin-style exponential curve
+// b(t) = b0 * e^(gamma*t). b0 is the genesis per-block baseline and gamma is
+// the (typically small, possibly negative) governance-set growth rate.
+func BaselineEmissionAtTime(b0 alloraMath.Dec, gamma alloraMath.Dec, t alloraMath.Dec) (alloraMath.Dec, error) {
+	exponent, err := gamma.Mul(t)
+	if err != nil {
+		return alloraMath.Dec{}, errors.Wrapf(err, "failed to compute gamma*t")
+	}
+	growth, err := alloraMath.Exp(exponent)
+	if err != nil {
+		return alloraMath.Dec{}, errors.Wrapf(err, "failed to compute e^(gamma*t)")
+	}
+	baseline, err := b0.Mul(growth)
+	if err != nil {
+		return alloraMath.Dec{}, errors.Wrapf(err, "failed to compute b0*e^(gamma*t)")
+	}
+	return baseline, nil
+}
+
+// CumSumBaselineAtTime returns the closed-form integral of BaselineEmissionAtTime
+// from network genesis (t=0) to effective network time t:
+//
+//	cumsumBaselineFn(t) = (b0/gamma) * (e^(gamma*t) - 1)
+func CumSumBaselineAtTime(b0 alloraMath.Dec, gamma alloraMath.Dec, t alloraMath.Dec) (alloraMath.Dec, error) {
+	if gamma.IsZero() {
+		// Degenerate case: a flat, non-growing baseline integrates linearly.
+		return b0.Mul(t)
+	}
+	exponent, err := gamma.Mul(t)
+	if err != nil {
+		return alloraMath.Dec{}, errors.Wrapf(err, "failed to compute gamma*t")
+	}
+	growth, err := alloraMath.Exp(exponent)
+	if err != nil {
+		return alloraMath.Dec{}, errors.Wrapf(err, "failed to compute e^(gamma*t)")
+	}
+	growthMinusOne, err := growth.Sub(alloraMath.OneDec())
+	if err != nil {
+		return alloraMath.Dec{}, errors.Wrapf(err, "failed to compute e^(gamma*t)-1")
+	}
+	b0OverGamma, err := b0.Quo(gamma)
+	if err != nil {
+		return alloraMath.Dec{}, errors.Wrapf(err, "failed to compute b0/gamma")
+	}
+	return b0OverGamma.Mul(growthMinusOne)
+}
+
+// minLnArgument floors the argument Ln() is evaluated at in
+// SolveEffectiveNetworkTime. With a negative gamma, CumSumBaselineAtTime
+// asymptotes at -b0/gamma as t->infinity, so cumSumRealized should never
+// reach it; but rounding drift as cumSumRealized approaches that asymptote
+// can still push 1+gamma*cumSumRealized/b0 to zero or below, which would
+// make Ln fail - and, unlike an ordinary EmitRewards error, permanently:
+// every following block recomputes the same out-of-domain argument, halting
+// emission chain-wide with no recovery short of a governance param change
+// that itself depends on emission working. Clamping keeps the solved time
+// finite (very large, since ln of a tiny positive number divided by a
+// negative gamma is still a large positive time) instead of erroring.
+var minLnArgument = alloraMath.MustNewDecFromString("0.000000000001")
+
+// SolveEffectiveNetworkTime inverts CumSumBaselineAtTime to find the effective
+// network time t' at which the cumulative baseline emission equals cumSumRealized,
+// i.e. it solves (b0/gamma) * (e^(gamma*t') - 1) = cumSumRealized for t':
+//
+//	t' = ln(1 + gamma*cumSumRealized/b0) / gamma
+//
+// Effective network time only advances when realized, actually-distributed
+// rewards catch up to the scheduled baseline, so unspent baseline from
+// low-activity epochs remains available for later emission.
+func SolveEffectiveNetworkTime(b0 alloraMath.Dec, gamma alloraMath.Dec, cumSumRealized alloraMath.Dec) (alloraMath.Dec, error) {
+	if gamma.IsZero() {
+		// Degenerate case: a flat baseline advances time linearly with realized rewards.
+		return cumSumRealized.Quo(b0)
+	}
+	gammaOverB0, err := gamma.Mul(cumSumRealized)
+	if err != nil {
+		return alloraMath.Dec{}, errors.Wrapf(err, "failed to compute gamma*cumSumRealized")
+	}
+	gammaOverB0, err = gammaOverB0.Quo(b0)
+	if err != nil {
+		return alloraMath.Dec{}, errors.Wrapf(err, "failed to compute gamma*cumSumRealized/b0")
+	}
+	onePlus, err := alloraMath.OneDec().Add(gammaOverB0)
+	if err != nil {
+		return alloraMath.Dec{}, errors.Wrapf(err, "failed to compute 1+gamma*cumSumRealized/b0")
+	}
+	if onePlus.Lte(minLnArgument) {
+		onePlus = minLnArgument
+	}
+	logged, err := alloraMath.Ln(onePlus)
+	if err != nil {
+		return alloraMath.Dec{}, errors.Wrapf(err, "failed to compute ln(1+gamma*cumSumRealized/b0)")
+	}
+	return logged.Quo(gamma)
+}
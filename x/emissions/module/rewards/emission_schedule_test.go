@@ -0,0 +1,54 @@
+package rewards
+
+import (
+	"testing"
+
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSolveEffectiveNetworkTimeInvertsCumSumBaselineAtTime(t *testing.T) {
+	b0 := alloraMath.MustNewDecFromString("1000")
+	gamma := alloraMath.MustNewDecFromString("0.01")
+	t0 := alloraMath.MustNewDecFromString("42")
+
+	cumSum, err := CumSumBaselineAtTime(b0, gamma, t0)
+	require.NoError(t, err)
+
+	solved, err := SolveEffectiveNetworkTime(b0, gamma, cumSum)
+	require.NoError(t, err)
+
+	diff, err := solved.Sub(t0)
+	require.NoError(t, err)
+	tolerance := alloraMath.MustNewDecFromString("0.0000000001")
+	require.True(t, diff.Abs().Lte(tolerance), "expected solved time %s to match input time %s", solved.String(), t0.String())
+}
+
+func TestSolveEffectiveNetworkTimeZeroGammaIsLinear(t *testing.T) {
+	b0 := alloraMath.MustNewDecFromString("500")
+	gamma := alloraMath.ZeroDec()
+	cumSumRealized := alloraMath.MustNewDecFromString("1000")
+
+	solved, err := SolveEffectiveNetworkTime(b0, gamma, cumSumRealized)
+	require.NoError(t, err)
+	require.Equal(t, "2.000000000000000000", solved.String())
+}
+
+func TestCumSumBaselineAtTimeZeroGammaIsLinear(t *testing.T) {
+	b0 := alloraMath.MustNewDecFromString("10")
+	gamma := alloraMath.ZeroDec()
+	tAt := alloraMath.MustNewDecFromString("7")
+
+	cumSum, err := CumSumBaselineAtTime(b0, gamma, tAt)
+	require.NoError(t, err)
+	require.Equal(t, "70.000000000000000000", cumSum.String())
+}
+
+func TestBaselineEmissionAtTimeZeroTimeIsB0(t *testing.T) {
+	b0 := alloraMath.MustNewDecFromString("123.456")
+	gamma := alloraMath.MustNewDecFromString("0.05")
+
+	emission, err := BaselineEmissionAtTime(b0, gamma, alloraMath.ZeroDec())
+	require.NoError(t, err)
+	require.Equal(t, b0.String(), emission.String())
+}
@@ -0,0 +1,182 @@
+package rewards
+
+import (
+	"fmt"
+	"runtime"
+	"sort"
+	"sync"
+
+	"cosmossdk.io/errors"
+	cosmosMath "cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// topicRewardTask is a unit of per-topic payout work that has passed the
+// cheap, serial pre-checks in EmitRewards (AddChurnReadyTopic, a valid reward
+// nonce) and is ready for the CPU-heavy, read-only reward computation.
+type topicRewardTask struct {
+	TopicId          TopicId
+	TopicReward      *alloraMath.Dec
+	TopicRewardNonce int64
+
+	// TopicFeeRevenue is the topic's fee revenue swept into Ecosystem as part
+	// of EmitRewards' aggregated transfer (see GenerateRewardsDistributionByTopic).
+	// It's the amount refundSkippedTopic must reverse on failure, which is a
+	// different quantity than TopicReward (the topic's share of the
+	// emission-schedule mint pool).
+	TopicFeeRevenue cosmosMath.Int
+}
+
+// topicPrunePlan is the result of the read-only half of pruneRecordsAfterRewards:
+// which nonce records for a topic are safe to prune. Applying it is a
+// KVStore mutation and must happen in the serial, deterministic-order stage.
+type topicPrunePlan struct {
+	TopicId     TopicId
+	OldestNonce int64
+}
+
+// topicRewardResult is the output of computing one topic's reward distribution
+// concurrently with its peers. Err is non-nil if computation failed for this
+// topic, in which case the topic is skipped during the serial payout stage.
+// Commit applies this task's KVStore writes (e.g. the entropy EMA state
+// GetReputersRewardFractions/GetInferenceTaskRewardFractions/
+// GetForecastingTaskRewardFractions persist via Set*RewardFraction) onto the
+// real context; callers must invoke it themselves, in sorted order, before
+// relying on that state.
+type topicRewardResult struct {
+	Task                     topicRewardTask
+	TotalRewardsDistribution []TaskRewards
+	PrunePlan                topicPrunePlan
+	Commit                   func()
+	Err                      error
+}
+
+// computeTopicRewardResultsConcurrently computes GenerateRewardsDistributionByTopicParticipant
+// and plans pruneRecordsAfterRewards for every task in parallel via a bounded
+// worker pool. The computation itself reads already-committed state
+// (GetNetworkLossBundleAtBlock, entropy calculations, ...) but also writes
+// entropy EMA state via GetReputersRewardFractions et al. - it is NOT a pure
+// function. Running those writes directly against the shared ctx from
+// multiple goroutines would race on its underlying CacheKVStore, so each
+// task instead runs against its own ctx.CacheContext() branch; the branch's
+// writes are cached in isolation and returned as result.Commit, left for the
+// caller to apply serially (in TopicId order) so validators still agree on
+// app-hash regardless of the concurrent stage's completion order. Since the
+// CPU-heavy entropy/fraction math still runs in parallel, this keeps the
+// performance win this function exists for while removing the race. Each
+// branch also gets its own infinite GasMeter (CacheContext only swaps the
+// MultiStore, not the meter), and each worker recovers panics from its task
+// into a topicRewardResult.Err, since a panic here runs on a goroutine baseapp's
+// EndBlock recover never sees.
+func computeTopicRewardResultsConcurrently(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	tasks []topicRewardTask,
+	moduleParams types.Params,
+	minEpochLengthRecordLimit int64,
+) []topicRewardResult {
+	results := make([]topicRewardResult, len(tasks))
+
+	workerCount := runtime.GOMAXPROCS(0)
+	if workerCount > len(tasks) {
+		workerCount = len(tasks)
+	}
+	if workerCount < 1 {
+		workerCount = 1
+	}
+
+	taskIndices := make(chan int, len(tasks))
+	for i := range tasks {
+		taskIndices <- i
+	}
+	close(taskIndices)
+
+	var wg sync.WaitGroup
+	wg.Add(workerCount)
+	for w := 0; w < workerCount; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range taskIndices {
+				results[i] = computeSingleTopicRewardResultRecovering(ctx, k, tasks[i], moduleParams, minEpochLengthRecordLimit)
+			}
+		}()
+	}
+	wg.Wait()
+
+	return results
+}
+
+// computeSingleTopicRewardResultRecovering wraps computeSingleTopicRewardResult
+// with a recover so a panic inside this task's goroutine - e.g. GasMeter.ConsumeGas
+// running out of gas, or a malformed-state panic from the entropy/reward-fraction
+// math - becomes a topicRewardResult.Err instead of crashing the process. The
+// old serial code relied on baseapp's EndBlock recover for this; that recover
+// runs on the goroutine that calls EmitRewards, not on these worker goroutines,
+// so each one needs its own.
+func computeSingleTopicRewardResultRecovering(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	task topicRewardTask,
+	moduleParams types.Params,
+	minEpochLengthRecordLimit int64,
+) (result topicRewardResult) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = topicRewardResult{Task: task, Err: fmt.Errorf("panic computing rewards for topic %d: %v", task.TopicId, r)}
+		}
+	}()
+	return computeSingleTopicRewardResult(ctx, k, task, moduleParams, minEpochLengthRecordLimit)
+}
+
+func computeSingleTopicRewardResult(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	task topicRewardTask,
+	moduleParams types.Params,
+	minEpochLengthRecordLimit int64,
+) topicRewardResult {
+	// Branch into an isolated cache so this task's EMA writes never touch the
+	// shared ctx while other goroutines are doing the same; commitCache is
+	// handed back for the caller to apply once it's this task's turn in the
+	// sorted serial stage. WithGasMeter gives the branch its own gas meter too,
+	// since CacheContext only swaps the MultiStore - without this, concurrent
+	// branches would all call ConsumeGas on the same shared, non-atomic counter.
+	cacheCtx, commitCache := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	distribution, err := GenerateRewardsDistributionByTopicParticipant(
+		cacheCtx,
+		k,
+		task.TopicId,
+		task.TopicReward,
+		task.TopicRewardNonce,
+		moduleParams,
+	)
+	if err != nil {
+		return topicRewardResult{Task: task, Err: errors.Wrapf(err, "failed to generate rewards for topic %d", task.TopicId)}
+	}
+
+	prunePlan, err := planPruneRecordsAfterRewards(cacheCtx, k, minEpochLengthRecordLimit, task.TopicId, task.TopicRewardNonce)
+	if err != nil {
+		return topicRewardResult{Task: task, Err: errors.Wrapf(err, "failed to plan pruning for topic %d", task.TopicId)}
+	}
+
+	return topicRewardResult{
+		Task:                     task,
+		TotalRewardsDistribution: distribution,
+		PrunePlan:                prunePlan,
+		Commit:                   commitCache,
+	}
+}
+
+// sortTopicRewardResultsByTopicId sorts results in place by ascending topic
+// id, giving the serial payout/prune stage a canonical, validator-agreed
+// application order regardless of the concurrent computation's completion order.
+func sortTopicRewardResultsByTopicId(results []topicRewardResult) {
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Task.TopicId < results[j].Task.TopicId
+	})
+}
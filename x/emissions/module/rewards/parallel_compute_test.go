@@ -0,0 +1,23 @@
+package rewards
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSortTopicRewardResultsByTopicIdOrdersAscending(t *testing.T) {
+	results := []topicRewardResult{
+		{Task: topicRewardTask{TopicId: 5}},
+		{Task: topicRewardTask{TopicId: 1}},
+		{Task: topicRewardTask{TopicId: 3}},
+	}
+
+	sortTopicRewardResultsByTopicId(results)
+
+	require.Equal(t, []TopicId{1, 3, 5}, []TopicId{
+		results[0].Task.TopicId,
+		results[1].Task.TopicId,
+		results[2].Task.TopicId,
+	})
+}
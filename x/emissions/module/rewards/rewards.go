@@ -7,6 +7,7 @@ import (
 	cosmosMath "cosmossdk.io/math"
 	"github.com/allora-network/allora-chain/app/params"
 	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/indexer"
 	"github.com/allora-network/allora-chain/x/emissions/keeper"
 	"github.com/allora-network/allora-chain/x/emissions/types"
 	mintTypes "github.com/allora-network/allora-chain/x/mint/types"
@@ -23,8 +24,51 @@ func EmitRewards(ctx sdk.Context, k keeper.Keeper, blockHeight BlockHeight) erro
 		return errors.Wrapf(err, "failed to get module params")
 	}
 
+	// Cap this block's emission at the scheduled baseline for the network's current
+	// effective time, so that issuance follows a smooth long-run curve rather than
+	// paying out GetTotalRewardToDistribute verbatim every block
+	effectiveNetworkTime, err := k.GetEffectiveNetworkTime(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get effective network time")
+	}
+	baselineEmission, err := BaselineEmissionAtTime(moduleParams.EmissionBaselineB0, moduleParams.EmissionBaselineGrowthRate, effectiveNetworkTime)
+	if err != nil {
+		return errors.Wrapf(err, "failed to compute baseline emission at effective network time")
+	}
+	if baselineEmission.Lt(totalReward) {
+		totalReward = baselineEmission
+	}
+
+	// CumSumBaseline tracks the schedule clock, not the consumption clock: it's
+	// the closed-form integral of the baseline curve from schedule activation
+	// to the current block height, so it advances every block regardless of
+	// whether the network actually absorbed the emission available to it.
+	// This must stay independent of effectiveNetworkTime (the
+	// consumption-lagging clock used above and in SolveEffectiveNetworkTime
+	// below), or the two clocks collapse into one self-referential value and
+	// CumSumBaseline stops meaning what its own doc comment says it means.
+	scheduleGenesisHeight, err := k.GetScheduleGenesisHeight(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get schedule genesis height")
+	}
+	// Elapsed blocks since activation, not blockHeight itself: if this feature
+	// activates at a non-zero chain height (any real upgrade), integrating
+	// from height 0 would instantly report a huge CumSumBaseline on the first
+	// post-upgrade block instead of starting the schedule clock at zero.
+	scheduleTime, err := alloraMath.NewDecFromInt64(int64(blockHeight) - scheduleGenesisHeight)
+	if err != nil {
+		return errors.Wrapf(err, "failed to convert elapsed schedule blocks to schedule time")
+	}
+	cumSumBaseline, err := CumSumBaselineAtTime(moduleParams.EmissionBaselineB0, moduleParams.EmissionBaselineGrowthRate, scheduleTime)
+	if err != nil {
+		return errors.Wrapf(err, "failed to compute cumulative baseline emission at block height")
+	}
+	if err := k.SetCumSumBaseline(ctx, cumSumBaseline); err != nil {
+		return errors.Wrapf(err, "failed to set cumulative baseline emission")
+	}
+
 	// Distribute rewards between topics
-	topicRewards, err := GenerateRewardsDistributionByTopic(ctx, k, moduleParams.MaxTopicsPerBlock, blockHeight, totalReward)
+	topicRewards, topicFeeRevenue, err := GenerateRewardsDistributionByTopic(ctx, k, moduleParams.MaxTopicsPerBlock, blockHeight, totalReward)
 	if err != nil {
 		return errors.Wrapf(err, "failed to generate total reward by topic")
 		// Will return nil if there are no topics to reward
@@ -32,7 +76,39 @@ func EmitRewards(ctx sdk.Context, k keeper.Keeper, blockHeight BlockHeight) erro
 		return nil
 	}
 
-	// for every topic
+	// Only rewards actually paid out to topics count as realized; advance effective
+	// network time by however much of the scheduled baseline that realized reward absorbs
+	realizedReward := alloraMath.ZeroDec()
+	for _, topicReward := range topicRewards {
+		realizedReward, err = realizedReward.Add(*topicReward)
+		if err != nil {
+			return errors.Wrapf(err, "failed to sum realized reward")
+		}
+	}
+	cumSumRealized, err := k.GetCumSumRealized(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get cumulative realized emission")
+	}
+	cumSumRealized, err = cumSumRealized.Add(realizedReward)
+	if err != nil {
+		return errors.Wrapf(err, "failed to accumulate realized emission")
+	}
+	if err := k.SetCumSumRealized(ctx, cumSumRealized); err != nil {
+		return errors.Wrapf(err, "failed to set cumulative realized emission")
+	}
+	effectiveNetworkTime, err = SolveEffectiveNetworkTime(moduleParams.EmissionBaselineB0, moduleParams.EmissionBaselineGrowthRate, cumSumRealized)
+	if err != nil {
+		return errors.Wrapf(err, "failed to solve effective network time")
+	}
+	if err := k.SetEffectiveNetworkTime(ctx, effectiveNetworkTime); err != nil {
+		return errors.Wrapf(err, "failed to set effective network time")
+	}
+
+	// Cheap, per-topic serial pre-checks: notify churn readiness and look up the
+	// reward nonce. Both are lightweight KVStore touches, so there's no benefit
+	// to parallelizing them, and AddChurnReadyTopic must gate whether a topic
+	// proceeds to the expensive computation stage at all.
+	tasks := make([]topicRewardTask, 0, len(topicRewards))
 	for topicId, topicReward := range topicRewards {
 		// To notify topic handler that the topic is ready for churn i.e. requests to be sent to workers and reputers
 		err = k.AddChurnReadyTopic(ctx, topicId)
@@ -53,39 +129,62 @@ func EmitRewards(ctx sdk.Context, k keeper.Keeper, blockHeight BlockHeight) erro
 			continue
 		}
 
-		// Distribute rewards between topic participants
-		totalRewardsDistribution, err := GenerateRewardsDistributionByTopicParticipant(ctx, k, topicId, topicReward, topicRewardNonce, moduleParams)
-		if err != nil {
+		tasks = append(tasks, topicRewardTask{
+			TopicId:          topicId,
+			TopicReward:      topicReward,
+			TopicRewardNonce: topicRewardNonce,
+			TopicFeeRevenue:  topicFeeRevenue[topicId],
+		})
+	}
+
+	// CPU-heavy reward computation runs concurrently across topics; each topic's
+	// state writes stay in its own cache branch until result.Commit below
+	results := computeTopicRewardResultsConcurrently(ctx, k, tasks, moduleParams, moduleParams.MinEpochLengthRecordLimit)
+
+	// Apply the final KVStore mutations serially, in a canonical topic-id-sorted
+	// order, so that validators agree on app-hash regardless of the order in
+	// which the concurrent computation stage above happened to finish
+	sortTopicRewardResultsByTopicId(results)
+	for _, result := range results {
+		if result.Err != nil {
 			fmt.Printf(
 				"Failed to Generate Rewards for Topic, Skipping:\nTopic Id %d\nTopic Reward Amount %s\nError:\n%s\n\n",
-				topicId,
-				topicReward.String(),
-				err,
+				result.Task.TopicId,
+				result.Task.TopicReward.String(),
+				result.Err,
 			)
+			refundSkippedTopic(ctx, k, result.Task.TopicId, result.Task.TopicFeeRevenue, "failed to generate rewards distribution")
 			continue
 		}
 
+		// Apply this topic's cached entropy-EMA writes to the real context now,
+		// in this loop's canonical TopicId order, so the concurrent computation
+		// stage's writes land deterministically instead of racing on a shared store
+		result.Commit()
+
 		// Pay out rewards to topic participants
-		err = payoutRewards(ctx, k, totalRewardsDistribution)
+		err = payoutRewards(ctx, k, result.TotalRewardsDistribution)
 		if err != nil {
 			fmt.Printf(
 				"Failed to pay out rewards for Topic, Skipping:\nTopic Id %d\nTopic Reward Amount %s\nError:\n%s\n\n",
-				topicId,
-				topicReward.String(),
+				result.Task.TopicId,
+				result.Task.TopicReward.String(),
 				err,
 			)
+			refundSkippedTopic(ctx, k, result.Task.TopicId, result.Task.TopicFeeRevenue, "failed to pay out rewards")
 			continue
 		}
 
 		// Prune records after rewards have been paid out
-		err = pruneRecordsAfterRewards(ctx, k, moduleParams.MinEpochLengthRecordLimit, topicId, topicRewardNonce)
+		err = applyPruneRecordsAfterRewards(ctx, k, result.PrunePlan)
 		if err != nil {
 			fmt.Printf(
 				"Failed to prune records after rewards for Topic, Skipping:\nTopic Id %d\nTopic Reward Amount %s\nError:\n%s\n\n",
-				topicId,
-				topicReward.String(),
+				result.Task.TopicId,
+				result.Task.TopicReward.String(),
 				err,
 			)
+			refundSkippedTopic(ctx, k, result.Task.TopicId, result.Task.TopicFeeRevenue, "failed to prune records after rewards")
 			continue
 		}
 	}
@@ -99,15 +198,15 @@ func GenerateRewardsDistributionByTopic(
 	maxTopicsPerBlock uint64,
 	blockHeight BlockHeight,
 	totalReward alloraMath.Dec,
-) (map[uint64]*alloraMath.Dec, error) {
+) (map[uint64]*alloraMath.Dec, map[TopicId]cosmosMath.Int, error) {
 	// Get Distribution of Rewards per Topic
 	weights, sumWeight, sumRevenue, err := GetRewardReadyTopicWeights(ctx, k, blockHeight)
 	if err != nil {
-		return nil, errors.Wrapf(err, "weights error")
+		return nil, nil, errors.Wrapf(err, "weights error")
 	}
 	if sumWeight.IsZero() {
 		fmt.Println("No weights, no rewards!")
-		return nil, nil
+		return nil, nil, nil
 	}
 
 	weightsOfActiveTopics, sumWeight, sumRevenue, err := InactivateTopicsAndUpdateSums(
@@ -120,7 +219,27 @@ func GenerateRewardsDistributionByTopic(
 		blockHeight,
 	)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to inactivate topics and update sums")
+		return nil, nil, errors.Wrapf(err, "failed to inactivate topics and update sums")
+	}
+
+	// Replace each topic's raw, instantaneous weight with its alpha-beta-filtered
+	// estimate so that single-block fee revenue spikes don't translate directly
+	// into reward volatility further down the pipeline
+	smoothingAlpha, smoothingBeta, err := k.GetParamsRewardSmoothingFactors(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to get reward smoothing factors")
+	}
+	weightsOfActiveTopics, sumWeight, err = SmoothTopicWeights(
+		ctx,
+		k,
+		weightsOfActiveTopics,
+		sumWeight,
+		blockHeight,
+		smoothingAlpha,
+		smoothingBeta,
+	)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to smooth topic weights")
 	}
 
 	// Sort remaining active topics by weight desc and skim the top via SortTopicsByReturnDescWithRandomTiebreaker() and param MaxTopicsPerBlock
@@ -129,21 +248,30 @@ func GenerateRewardsDistributionByTopic(
 	// Return the revenue to those topics that didn't make the cut
 	// Loop though weightsOfActiveTopics and if the topic is not in weightsOfTopActiveTopics, add to running revenue sum
 	sumRevenueOfBottomTopics := cosmosMath.ZeroInt()
+	// Fee revenue of topics that did make the cut. CalcTopicRewards hands this to
+	// the indexer alongside the reward it computes for the topic, and EmitRewards
+	// needs it too: it's the exact amount that topic's share of the aggregate
+	// SendCoinsFromModuleToModule transfer below represents, so refundSkippedTopic
+	// can reverse precisely that amount if the topic is later skipped mid-payout.
+	topTopicFeeRevenue := make(map[TopicId]cosmosMath.Int, len(weightsOfTopActiveTopics))
 	for topicId := range weightsOfActiveTopics {
+		topicFeeRevenue, err := k.GetTopicFeeRevenue(ctx, topicId)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to get topic fee revenue")
+		}
+
 		// If the topic is not in the top active topics, add its revenue to the running sum
 		if _, ok := weightsOfTopActiveTopics[topicId]; !ok {
-			topicFeeRevenue, err := k.GetTopicFeeRevenue(ctx, topicId)
-			if err != nil {
-				return nil, errors.Wrapf(err, "failed to get topic fee revenue")
-			}
 			sumRevenueOfBottomTopics = sumRevenueOfBottomTopics.Add(topicFeeRevenue.Revenue)
+		} else {
+			topTopicFeeRevenue[topicId] = topicFeeRevenue.Revenue
 		}
 
 		// This way we won't double count from this earlier epoch revenue the next epoch
 		// This must come after GetTopicFeeRevenue() is last called per topic because otherwise the returned revenue will be zero
 		err = k.ResetTopicFeeRevenue(ctx, topicId, blockHeight)
 		if err != nil {
-			return nil, errors.Wrapf(err, "failed to reset topic fee revenue")
+			return nil, nil, errors.Wrapf(err, "failed to reset topic fee revenue")
 		}
 	}
 
@@ -157,15 +285,15 @@ func GenerateRewardsDistributionByTopic(
 		sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, cosmosMath.NewInt(sumRevenue.Sub(sumRevenueOfBottomTopics).BigInt().Int64()))))
 	if err != nil {
 		fmt.Println("Error sending coins from module to module: ", err)
-		return nil, err
+		return nil, nil, err
 	}
 
-	topicRewards, err := CalcTopicRewards(ctx, k, weightsOfTopActiveTopics, sumWeight, totalReward)
+	topicRewards, err := CalcTopicRewards(ctx, k, weightsOfTopActiveTopics, sumWeight, totalReward, topTopicFeeRevenue)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to calculate topic rewards")
+		return nil, nil, errors.Wrapf(err, "failed to calculate topic rewards")
 	}
 
-	return topicRewards, nil
+	return topicRewards, topTopicFeeRevenue, nil
 }
 
 func InactivateTopicsAndUpdateSums(
@@ -196,6 +324,12 @@ func InactivateTopicsAndUpdateSums(
 			if err != nil {
 				return nil, alloraMath.Dec{}, cosmosMath.Int{}, errors.Wrapf(err, "failed to inactivate topic")
 			}
+			k.Indexer().EmitTopicInactivation(indexer.TopicInactivationRecord{
+				BlockHeight: ctx.BlockHeight(),
+				TopicId:     topicId,
+				Weight:      weight.String(),
+				MinWeight:   minTopicWeight.String(),
+			})
 
 			// This way we won't double count from this earlier epoch revenue the next time this topic is activated
 			// This must come after GetTopicFeeRevenue() is last called per topic because otherwise the returned revenue will be zero
@@ -231,6 +365,7 @@ func CalcTopicRewards(
 	weights map[uint64]*alloraMath.Dec,
 	sumWeight alloraMath.Dec,
 	totalReward alloraMath.Dec,
+	topicFeeRevenue map[TopicId]cosmosMath.Int,
 ) (
 	map[uint64]*alloraMath.Dec,
 	error,
@@ -246,6 +381,18 @@ func CalcTopicRewards(
 			return nil, errors.Wrapf(err, "topic reward error")
 		}
 		topicRewards[topicId] = &topicReward
+
+		feeRevenue := cosmosMath.ZeroInt()
+		if revenue, ok := topicFeeRevenue[topicId]; ok {
+			feeRevenue = revenue
+		}
+		k.Indexer().EmitTopicReward(indexer.TopicRewardRecord{
+			BlockHeight: ctx.BlockHeight(),
+			TopicId:     topicId,
+			Weight:      weight.String(),
+			Reward:      topicReward.String(),
+			FeeRevenue:  feeRevenue.String(),
+		})
 	}
 	return topicRewards, nil
 }
@@ -463,28 +610,35 @@ func payoutRewards(
 				return errors.Wrapf(err, "failed to send coins from rewards module to payout address")
 			}
 		}
+
+		k.Indexer().EmitParticipantReward(indexer.ParticipantRewardRecord{
+			BlockHeight: ctx.BlockHeight(),
+			TopicId:     reward.TopicId,
+			Address:     reward.Address.String(),
+			Type:        fmt.Sprintf("%v", reward.Type),
+			Amount:      reward.Reward.String(),
+		})
 	}
 
 	return nil
 }
 
-func pruneRecordsAfterRewards(
+// planPruneRecordsAfterRewards computes which nonce a topic's records are safe
+// to prune behind, without mutating any state. It is the read-only half of
+// the old pruneRecordsAfterRewards, split out so it can run concurrently with
+// other topics' reward computation; applyPruneRecordsAfterRewards performs
+// the actual KVStore mutation once the plan is consumed in deterministic order.
+func planPruneRecordsAfterRewards(
 	ctx sdk.Context,
 	k keeper.Keeper,
 	minEpochLengthRecordLimit int64,
 	topicId uint64,
 	topicRewardNonce int64,
-) error {
-	// Delete topic reward nonce
-	err := k.DeleteTopicRewardNonce(ctx, topicId)
-	if err != nil {
-		return errors.Wrapf(err, "failed to delete topic reward nonce")
-	}
-
+) (topicPrunePlan, error) {
 	// Get oldest unfulfilled nonce - delete everything behind it
 	unfulfilledNonces, err := k.GetUnfulfilledReputerNonces(ctx, topicId)
 	if err != nil {
-		return err
+		return topicPrunePlan{}, err
 	}
 
 	// Assume the oldest nonce is the topic reward nonce
@@ -501,7 +655,7 @@ func pruneRecordsAfterRewards(
 
 	topic, err := k.GetTopic(ctx, topicId)
 	if err != nil {
-		return errors.Wrapf(err, "failed to get topic")
+		return topicPrunePlan{}, errors.Wrapf(err, "failed to get topic")
 	}
 
 	// Prune records x EpochsLengths behind the oldest nonce
@@ -509,8 +663,25 @@ func pruneRecordsAfterRewards(
 	// unfulfilled nonces to be fulfilled
 	oldestNonce -= minEpochLengthRecordLimit * topic.EpochLength
 
+	return topicPrunePlan{TopicId: topicId, OldestNonce: oldestNonce}, nil
+}
+
+// applyPruneRecordsAfterRewards performs the KVStore mutations planned by
+// planPruneRecordsAfterRewards. Callers must apply plans for different topics
+// in a deterministic order (by topic id) to preserve app-hash agreement.
+func applyPruneRecordsAfterRewards(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	plan topicPrunePlan,
+) error {
+	// Delete topic reward nonce
+	err := k.DeleteTopicRewardNonce(ctx, plan.TopicId)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete topic reward nonce")
+	}
+
 	// Prune old records after rewards have been paid out
-	err = k.PruneRecordsAfterRewards(ctx, topicId, oldestNonce)
+	err = k.PruneRecordsAfterRewards(ctx, plan.TopicId, plan.OldestNonce)
 	if err != nil {
 		return errors.Wrapf(err, "failed to prune records after rewards")
 	}
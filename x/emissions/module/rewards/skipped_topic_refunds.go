@@ -0,0 +1,74 @@
+package rewards
+
+import (
+	"fmt"
+
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	mintTypes "github.com/allora-network/allora-chain/x/mint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// refundSkippedTopic compensates for a topic being skipped mid-EmitRewards
+// after its fee revenue was already swept into the Ecosystem module account
+// as part of EmitRewards' aggregated transfer (see the topTopicFeeRevenue
+// bookkeeping in GenerateRewardsDistributionByTopic): it reverses exactly
+// that topic's feeRevenue back to AlloraRequestsAccountName, re-credits the
+// topic's fee revenue so next epoch treats this one as though it never
+// happened, and records the event so it's auditable via the
+// SkippedTopicRefunds query. The three mutations are applied through a
+// ctx.CacheContext() branch and committed together, so a mid-sequence
+// failure leaves no partial refund rather than silently stopping short.
+func refundSkippedTopic(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	topicId TopicId,
+	feeRevenue cosmosMath.Int,
+	reason string,
+) {
+	if !feeRevenue.IsPositive() {
+		return
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+
+	err := k.BankKeeper().SendCoinsFromModuleToModule(
+		cacheCtx,
+		mintTypes.EcosystemModuleName,
+		types.AlloraRequestsAccountName,
+		sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, feeRevenue)),
+	)
+	if err != nil {
+		logSkippedTopicRefundFailure(topicId, "failed to reverse ecosystem transfer", err)
+		return
+	}
+
+	if err := k.RecreditTopicFeeRevenue(cacheCtx, topicId, feeRevenue); err != nil {
+		logSkippedTopicRefundFailure(topicId, "failed to re-credit topic fee revenue", err)
+		return
+	}
+
+	err = k.SetSkippedTopicRefund(cacheCtx, types.SkippedTopicRefund{
+		TopicId:      topicId,
+		BlockHeight:  ctx.BlockHeight(),
+		Reason:       reason,
+		RefundAmount: feeRevenue.String(),
+	})
+	if err != nil {
+		logSkippedTopicRefundFailure(topicId, "failed to record skipped topic refund", err)
+		return
+	}
+
+	write()
+}
+
+func logSkippedTopicRefundFailure(topicId TopicId, msg string, err error) {
+	fmt.Printf(
+		"Failed to refund skipped topic:\nTopic Id %d\n%s\nError:\n%s\n\n",
+		topicId,
+		msg,
+		err,
+	)
+}
@@ -0,0 +1,151 @@
+package rewards
+
+import (
+	"cosmossdk.io/errors"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GlobalFilterEstimateTopicId is the sentinel topic id used to key the single
+// network-wide FilterEstimate, distinct from any real topic id (topic ids are
+// assigned starting at 1).
+const GlobalFilterEstimateTopicId = TopicId(0)
+
+// UpdateFilterEstimate advances a discrete alpha-beta (position/velocity)
+// filter by one observation. Given the prior estimate, a new observation
+// x_t taken `dt` blocks after the prior update, and governance-set smoothing
+// params alpha and beta, it predicts the position forward by the elapsed
+// time, then corrects the position and velocity by the observation residual:
+//
+//	p' = p + v*dt
+//	p  = p' + alpha*(x_t - p')
+//	v  = v  + (beta/dt)*(x_t - p')
+//
+// If this is the first observation for the series (LastUpdateHeight is zero),
+// or currentHeight is not past LastUpdateHeight, the filter is initialized
+// directly from the observation with zero velocity.
+func UpdateFilterEstimate(
+	prev types.FilterEstimate,
+	observation alloraMath.Dec,
+	currentHeight int64,
+	alpha alloraMath.Dec,
+	beta alloraMath.Dec,
+) (types.FilterEstimate, error) {
+	if prev.LastUpdateHeight == 0 || currentHeight <= prev.LastUpdateHeight {
+		return types.FilterEstimate{
+			Position:         observation,
+			Velocity:         alloraMath.ZeroDec(),
+			LastUpdateHeight: currentHeight,
+		}, nil
+	}
+
+	dt, err := alloraMath.NewDecFromInt64(currentHeight - prev.LastUpdateHeight)
+	if err != nil {
+		return types.FilterEstimate{}, errors.Wrapf(err, "failed to compute dt")
+	}
+
+	predictedPosition, err := prev.Velocity.Mul(dt)
+	if err != nil {
+		return types.FilterEstimate{}, errors.Wrapf(err, "failed to predict velocity term")
+	}
+	predictedPosition, err = prev.Position.Add(predictedPosition)
+	if err != nil {
+		return types.FilterEstimate{}, errors.Wrapf(err, "failed to predict position")
+	}
+
+	residual, err := observation.Sub(predictedPosition)
+	if err != nil {
+		return types.FilterEstimate{}, errors.Wrapf(err, "failed to compute residual")
+	}
+
+	alphaResidual, err := alpha.Mul(residual)
+	if err != nil {
+		return types.FilterEstimate{}, errors.Wrapf(err, "failed to scale residual by alpha")
+	}
+	newPosition, err := predictedPosition.Add(alphaResidual)
+	if err != nil {
+		return types.FilterEstimate{}, errors.Wrapf(err, "failed to update position")
+	}
+
+	betaOverDt, err := beta.Quo(dt)
+	if err != nil {
+		return types.FilterEstimate{}, errors.Wrapf(err, "failed to compute beta/dt")
+	}
+	velocityCorrection, err := betaOverDt.Mul(residual)
+	if err != nil {
+		return types.FilterEstimate{}, errors.Wrapf(err, "failed to scale residual by beta/dt")
+	}
+	newVelocity, err := prev.Velocity.Add(velocityCorrection)
+	if err != nil {
+		return types.FilterEstimate{}, errors.Wrapf(err, "failed to update velocity")
+	}
+
+	return types.FilterEstimate{
+		Position:         newPosition,
+		Velocity:         newVelocity,
+		LastUpdateHeight: currentHeight,
+	}, nil
+}
+
+// EstimateAt extrapolates a FilterEstimate forward to an arbitrary height
+// using its tracked velocity, letting external clients project expected topic
+// rewards between epochs without waiting for the next observation.
+func EstimateAt(estimate types.FilterEstimate, height int64) (alloraMath.Dec, error) {
+	dt, err := alloraMath.NewDecFromInt64(height - estimate.LastUpdateHeight)
+	if err != nil {
+		return alloraMath.Dec{}, errors.Wrapf(err, "failed to compute dt")
+	}
+	extrapolated, err := estimate.Velocity.Mul(dt)
+	if err != nil {
+		return alloraMath.Dec{}, errors.Wrapf(err, "failed to extrapolate velocity term")
+	}
+	return estimate.Position.Add(extrapolated)
+}
+
+// SmoothTopicWeights replaces each topic's raw, instantaneous reward weight
+// with the position of its per-topic alpha-beta filter, and updates a global
+// filter over the weight sum in lockstep, persisting the new filter states to
+// the keeper. This keeps single-block fee revenue spikes from translating
+// directly into reward volatility in CalcTopicRewards.
+func SmoothTopicWeights(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	weights map[TopicId]*alloraMath.Dec,
+	sumWeight alloraMath.Dec,
+	blockHeight BlockHeight,
+	alpha alloraMath.Dec,
+	beta alloraMath.Dec,
+) (map[TopicId]*alloraMath.Dec, alloraMath.Dec, error) {
+	smoothedWeights := make(map[TopicId]*alloraMath.Dec, len(weights))
+	for topicId, weight := range weights {
+		prev, err := k.GetFilterEstimate(ctx, topicId)
+		if err != nil {
+			return nil, alloraMath.Dec{}, errors.Wrapf(err, "failed to get filter estimate for topic %d", topicId)
+		}
+		updated, err := UpdateFilterEstimate(prev, *weight, int64(blockHeight), alpha, beta)
+		if err != nil {
+			return nil, alloraMath.Dec{}, errors.Wrapf(err, "failed to update filter estimate for topic %d", topicId)
+		}
+		if err := k.SetFilterEstimate(ctx, topicId, updated); err != nil {
+			return nil, alloraMath.Dec{}, errors.Wrapf(err, "failed to set filter estimate for topic %d", topicId)
+		}
+		smoothedPosition := updated.Position
+		smoothedWeights[topicId] = &smoothedPosition
+	}
+
+	prevGlobal, err := k.GetFilterEstimate(ctx, GlobalFilterEstimateTopicId)
+	if err != nil {
+		return nil, alloraMath.Dec{}, errors.Wrapf(err, "failed to get global filter estimate")
+	}
+	updatedGlobal, err := UpdateFilterEstimate(prevGlobal, sumWeight, int64(blockHeight), alpha, beta)
+	if err != nil {
+		return nil, alloraMath.Dec{}, errors.Wrapf(err, "failed to update global filter estimate")
+	}
+	if err := k.SetFilterEstimate(ctx, GlobalFilterEstimateTopicId, updatedGlobal); err != nil {
+		return nil, alloraMath.Dec{}, errors.Wrapf(err, "failed to set global filter estimate")
+	}
+
+	return smoothedWeights, updatedGlobal.Position, nil
+}
@@ -0,0 +1,51 @@
+package rewards
+
+import (
+	"testing"
+
+	"github.com/allora-network/allora-chain/x/emissions/types"
+
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateFilterEstimateInitializesFromFirstObservation(t *testing.T) {
+	observation := alloraMath.MustNewDecFromString("10")
+	alpha := alloraMath.MustNewDecFromString("0.5")
+	beta := alloraMath.MustNewDecFromString("0.1")
+
+	updated, err := UpdateFilterEstimate(types.FilterEstimate{}, observation, 5, alpha, beta)
+	require.NoError(t, err)
+	require.Equal(t, observation.String(), updated.Position.String())
+	require.True(t, updated.Velocity.IsZero())
+	require.Equal(t, int64(5), updated.LastUpdateHeight)
+}
+
+func TestUpdateFilterEstimateCorrectsTowardObservation(t *testing.T) {
+	prev := types.FilterEstimate{
+		Position:         alloraMath.MustNewDecFromString("10"),
+		Velocity:         alloraMath.ZeroDec(),
+		LastUpdateHeight: 1,
+	}
+	alpha := alloraMath.MustNewDecFromString("0.5")
+	beta := alloraMath.MustNewDecFromString("0.1")
+
+	updated, err := UpdateFilterEstimate(prev, alloraMath.MustNewDecFromString("20"), 2, alpha, beta)
+	require.NoError(t, err)
+	// residual = 20 - 10 = 10; position = 10 + 0.5*10 = 15
+	require.Equal(t, "15.000000000000000000", updated.Position.String())
+	// velocity = 0 + (0.1/1)*10 = 1
+	require.Equal(t, "1.000000000000000000", updated.Velocity.String())
+}
+
+func TestEstimateAtExtrapolatesByVelocity(t *testing.T) {
+	estimate := types.FilterEstimate{
+		Position:         alloraMath.MustNewDecFromString("15"),
+		Velocity:         alloraMath.MustNewDecFromString("2"),
+		LastUpdateHeight: 10,
+	}
+
+	extrapolated, err := EstimateAt(estimate, 13)
+	require.NoError(t, err)
+	require.Equal(t, "21.000000000000000000", extrapolated.String())
+}
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// This file hand-implements the proto.Message (Reset/String/ProtoMessage) and
+// sdk.Msg (ValidateBasic/GetSigners) methods that protoc-gen-gocosmos would
+// otherwise generate from proto/emissions/v1/reward_pipeline.proto. It exists
+// so these types are real gRPC-reachable messages today; delete it once that
+// proto file is wired into codegen and its .pb.go replaces this by hand.
+
+func (m *FilterEstimate) Reset()         { *m = FilterEstimate{} }
+func (m *FilterEstimate) String() string { return proto.CompactTextString(m) }
+func (*FilterEstimate) ProtoMessage()    {}
+
+func (m *SkippedTopicRefund) Reset()         { *m = SkippedTopicRefund{} }
+func (m *SkippedTopicRefund) String() string { return proto.CompactTextString(m) }
+func (*SkippedTopicRefund) ProtoMessage()    {}
+
+func (m *QueryEmissionScheduleStateRequest) Reset()         { *m = QueryEmissionScheduleStateRequest{} }
+func (m *QueryEmissionScheduleStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEmissionScheduleStateRequest) ProtoMessage()    {}
+
+func (m *QueryEmissionScheduleStateResponse) Reset()         { *m = QueryEmissionScheduleStateResponse{} }
+func (m *QueryEmissionScheduleStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEmissionScheduleStateResponse) ProtoMessage()    {}
+
+func (m *QueryFilterEstimateRequest) Reset()         { *m = QueryFilterEstimateRequest{} }
+func (m *QueryFilterEstimateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFilterEstimateRequest) ProtoMessage()    {}
+
+func (m *QueryFilterEstimateResponse) Reset()         { *m = QueryFilterEstimateResponse{} }
+func (m *QueryFilterEstimateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFilterEstimateResponse) ProtoMessage()    {}
+
+func (m *QuerySkippedTopicRefundsRequest) Reset()         { *m = QuerySkippedTopicRefundsRequest{} }
+func (m *QuerySkippedTopicRefundsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySkippedTopicRefundsRequest) ProtoMessage()    {}
+
+func (m *QuerySkippedTopicRefundsResponse) Reset()         { *m = QuerySkippedTopicRefundsResponse{} }
+func (m *QuerySkippedTopicRefundsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySkippedTopicRefundsResponse) ProtoMessage()    {}
+
+func (m *MsgAcknowledgeSkippedRefund) Reset()         { *m = MsgAcknowledgeSkippedRefund{} }
+func (m *MsgAcknowledgeSkippedRefund) String() string { return proto.CompactTextString(m) }
+func (*MsgAcknowledgeSkippedRefund) ProtoMessage()    {}
+
+func (m *MsgAcknowledgeSkippedRefundResponse) Reset()         { *m = MsgAcknowledgeSkippedRefundResponse{} }
+func (m *MsgAcknowledgeSkippedRefundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcknowledgeSkippedRefundResponse) ProtoMessage()    {}
+
+var _ sdk.Msg = &MsgAcknowledgeSkippedRefund{}
+
+// ValidateBasic performs stateless sanity checks on the message.
+func (msg *MsgAcknowledgeSkippedRefund) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	return nil
+}
+
+// GetSigners returns the message's required signer, used by the legacy amino signing path.
+func (msg *MsgAcknowledgeSkippedRefund) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
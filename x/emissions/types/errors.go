@@ -0,0 +1,9 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/emissions module sentinel errors, registered under the module's unique
+// codespace per the standard Cosmos SDK errors.Register convention.
+var (
+	ErrInvalidParams = errors.Register(ModuleName, 2, "invalid params")
+)
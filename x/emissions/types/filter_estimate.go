@@ -0,0 +1,15 @@
+package types
+
+import (
+	alloraMath "github.com/allora-network/allora-chain/math"
+)
+
+// FilterEstimate is the state of a discrete alpha-beta (position/velocity)
+// filter tracking a noisy, block-by-block observed quantity - e.g. a topic's
+// raw reward weight - so that downstream consumers see a smoothed estimate
+// rather than the instantaneous, fee-revenue-driven value.
+type FilterEstimate struct {
+	Position         alloraMath.Dec `protobuf:"bytes,1,opt,name=position,proto3" json:"position"`
+	Velocity         alloraMath.Dec `protobuf:"bytes,2,opt,name=velocity,proto3" json:"velocity"`
+	LastUpdateHeight int64          `protobuf:"varint,3,opt,name=last_update_height,json=lastUpdateHeight,proto3" json:"last_update_height,omitempty"`
+}
@@ -0,0 +1,21 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName is the name of the emissions module, used as its errors.Register codespace.
+	ModuleName = "emissions"
+)
+
+// Collection prefixes for state introduced by the reward-pipeline work in
+// x/emissions/module/rewards. The broader module's own prefixes live
+// alongside its other (pre-existing) collections, not duplicated here.
+var (
+	ParamsKey                = collections.NewPrefix(100)
+	CumSumBaselineKey        = collections.NewPrefix(101)
+	CumSumRealizedKey        = collections.NewPrefix(102)
+	EffectiveNetworkTimeKey  = collections.NewPrefix(103)
+	TopicFilterEstimatesKey  = collections.NewPrefix(104)
+	SkippedTopicRefundsKey   = collections.NewPrefix(105)
+	ScheduleGenesisHeightKey = collections.NewPrefix(106)
+)
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	alloraMath "github.com/allora-network/allora-chain/math"
+)
+
+// Params is the emissions module's governance-settable parameter set. Only
+// the fields touched by x/emissions/module/rewards are declared here; the
+// module's full parameter set is broader than this slice of it.
+type Params struct {
+	MaxTopicsPerBlock         uint64         `protobuf:"varint,1,opt,name=max_topics_per_block,json=maxTopicsPerBlock,proto3" json:"max_topics_per_block,omitempty"`
+	MinEpochLengthRecordLimit int64          `protobuf:"varint,2,opt,name=min_epoch_length_record_limit,json=minEpochLengthRecordLimit,proto3" json:"min_epoch_length_record_limit,omitempty"`
+	MinTopicWeight            alloraMath.Dec `protobuf:"bytes,3,opt,name=min_topic_weight,json=minTopicWeight,proto3" json:"min_topic_weight"`
+	BetaEntropy               alloraMath.Dec `protobuf:"bytes,4,opt,name=beta_entropy,json=betaEntropy,proto3" json:"beta_entropy"`
+	SigmoidA                  alloraMath.Dec `protobuf:"bytes,5,opt,name=sigmoid_a,json=sigmoidA,proto3" json:"sigmoid_a"`
+	SigmoidB                  alloraMath.Dec `protobuf:"bytes,6,opt,name=sigmoid_b,json=sigmoidB,proto3" json:"sigmoid_b"`
+	PRewardSpread             alloraMath.Dec `protobuf:"bytes,7,opt,name=p_reward_spread,json=pRewardSpread,proto3" json:"p_reward_spread"`
+	TaskRewardAlpha           alloraMath.Dec `protobuf:"bytes,8,opt,name=task_reward_alpha,json=taskRewardAlpha,proto3" json:"task_reward_alpha"`
+
+	// EmissionBaselineB0 is the genesis per-block baseline emission rate b0
+	// for the Filecoin-style baseline-vs-realized emission schedule.
+	EmissionBaselineB0 alloraMath.Dec `protobuf:"bytes,9,opt,name=emission_baseline_b0,json=emissionBaselineB0,proto3" json:"emission_baseline_b0"`
+	// EmissionBaselineGrowthRate is gamma, the (typically small, possibly
+	// negative) continuous growth rate of the baseline emission curve.
+	EmissionBaselineGrowthRate alloraMath.Dec `protobuf:"bytes,10,opt,name=emission_baseline_growth_rate,json=emissionBaselineGrowthRate,proto3" json:"emission_baseline_growth_rate"`
+
+	// RewardSmoothingAlpha is the position gain of the alpha-beta filter used
+	// to smooth per-topic reward weights.
+	RewardSmoothingAlpha alloraMath.Dec `protobuf:"bytes,11,opt,name=reward_smoothing_alpha,json=rewardSmoothingAlpha,proto3" json:"reward_smoothing_alpha"`
+	// RewardSmoothingBeta is the velocity gain of the same filter.
+	RewardSmoothingBeta alloraMath.Dec `protobuf:"bytes,12,opt,name=reward_smoothing_beta,json=rewardSmoothingBeta,proto3" json:"reward_smoothing_beta"`
+}
+
+// DefaultParams returns the emissions module's default parameters. The
+// baseline emission defaults to a flat (non-growing) curve and the smoothing
+// filter defaults to gains that weight the latest observation only slightly
+// more than a straight running average, so existing networks upgrading into
+// these params see a gentle transition rather than a discontinuity.
+func DefaultParams() Params {
+	return Params{
+		MaxTopicsPerBlock:          100,
+		MinEpochLengthRecordLimit:  3,
+		MinTopicWeight:             alloraMath.MustNewDecFromString("100"),
+		BetaEntropy:                alloraMath.MustNewDecFromString("0.25"),
+		SigmoidA:                   alloraMath.MustNewDecFromString("8"),
+		SigmoidB:                   alloraMath.MustNewDecFromString("0.5"),
+		PRewardSpread:              alloraMath.MustNewDecFromString("0.75"),
+		TaskRewardAlpha:            alloraMath.MustNewDecFromString("0.1"),
+		EmissionBaselineB0:         alloraMath.MustNewDecFromString("1000000"),
+		EmissionBaselineGrowthRate: alloraMath.ZeroDec(),
+		RewardSmoothingAlpha:       alloraMath.MustNewDecFromString("0.3"),
+		RewardSmoothingBeta:        alloraMath.MustNewDecFromString("0.1"),
+	}
+}
+
+// Validate checks that MaxTopicsPerBlock, MinEpochLengthRecordLimit and
+// EmissionBaselineB0 (a divisor in the emission schedule math) are positive
+// and that the two reward smoothing gains are in [0,1]. The other parameters
+// are not checked, and EmissionBaselineGrowthRate may be negative.
+func (p Params) Validate() error {
+	if p.MaxTopicsPerBlock == 0 {
+		return errors.Wrapf(ErrInvalidParams, "max_topics_per_block must be positive")
+	}
+	if p.MinEpochLengthRecordLimit <= 0 {
+		return errors.Wrapf(ErrInvalidParams, "min_epoch_length_record_limit must be positive")
+	}
+	if p.EmissionBaselineB0.IsZero() || p.EmissionBaselineB0.IsNegative() {
+		return errors.Wrapf(ErrInvalidParams, "emission_baseline_b0 must be positive")
+	}
+	if err := validateUnitInterval(p.RewardSmoothingAlpha, "reward_smoothing_alpha"); err != nil {
+		return err
+	}
+	if err := validateUnitInterval(p.RewardSmoothingBeta, "reward_smoothing_beta"); err != nil {
+		return err
+	}
+	return nil
+}
+
+func validateUnitInterval(d alloraMath.Dec, name string) error {
+	if d.IsNegative() || d.Gt(alloraMath.OneDec()) {
+		return errors.Wrapf(ErrInvalidParams, "%s must be in [0,1], got %s", name, d.String())
+	}
+	return nil
+}
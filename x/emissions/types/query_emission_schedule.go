@@ -0,0 +1,15 @@
+package types
+
+// QueryEmissionScheduleStateRequest is the request type for the
+// Query/EmissionScheduleState RPC method, declared alongside the module's
+// other query types pending the next protobuf regeneration.
+type QueryEmissionScheduleStateRequest struct{}
+
+// QueryEmissionScheduleStateResponse reports the current state of the
+// baseline-vs-realized emission schedule, letting operators and explorers
+// see how far actual emission has diverged from the scheduled baseline.
+type QueryEmissionScheduleStateResponse struct {
+	CumSumBaseline       string `protobuf:"bytes,1,opt,name=cum_sum_baseline,json=cumSumBaseline,proto3" json:"cum_sum_baseline,omitempty"`
+	CumSumRealized       string `protobuf:"bytes,2,opt,name=cum_sum_realized,json=cumSumRealized,proto3" json:"cum_sum_realized,omitempty"`
+	EffectiveNetworkTime string `protobuf:"bytes,3,opt,name=effective_network_time,json=effectiveNetworkTime,proto3" json:"effective_network_time,omitempty"`
+}
@@ -0,0 +1,16 @@
+package types
+
+// QueryFilterEstimateRequest is the request type for the Query/FilterEstimate
+// RPC method, declared alongside the module's other query types pending the
+// next protobuf regeneration.
+type QueryFilterEstimateRequest struct {
+	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Height  int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+// QueryFilterEstimateResponse reports a topic's (or, for the global filter
+// sentinel, the network's) smoothed reward weight extrapolated to the
+// requested height.
+type QueryFilterEstimateResponse struct {
+	Estimate string `protobuf:"bytes,1,opt,name=estimate,proto3" json:"estimate,omitempty"`
+}
@@ -0,0 +1,16 @@
+package types
+
+// QuerySkippedTopicRefundsRequest is the request type for the
+// Query/SkippedTopicRefunds RPC method, declared alongside the module's other
+// query types pending the next protobuf regeneration.
+type QuerySkippedTopicRefundsRequest struct {
+	TopicId    uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	FromHeight int64  `protobuf:"varint,2,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	ToHeight   int64  `protobuf:"varint,3,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+}
+
+// QuerySkippedTopicRefundsResponse reports every refund recorded for a topic
+// in the requested block height range.
+type QuerySkippedTopicRefundsResponse struct {
+	Refunds []SkippedTopicRefund `protobuf:"bytes,1,rep,name=refunds,proto3" json:"refunds"`
+}
@@ -0,0 +1,13 @@
+package types
+
+// SkippedTopicRefund records a topic being skipped mid-EmitRewards after its
+// fee revenue was already swept into the Ecosystem module account, and the
+// compensating refund issued back to it. It turns what used to be a silent
+// fmt.Printf-and-continue into a first-class, auditable state transition.
+type SkippedTopicRefund struct {
+	TopicId      uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight  int64  `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Reason       string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	RefundAmount string `protobuf:"bytes,4,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount,omitempty"`
+	Acknowledged bool   `protobuf:"varint,5,opt,name=acknowledged,proto3" json:"acknowledged,omitempty"`
+}
@@ -0,0 +1,13 @@
+package types
+
+// MsgAcknowledgeSkippedRefund is a governance-gated message that marks a
+// recorded SkippedTopicRefund as acknowledged, so operators have a way to
+// signal a refund has been reconciled without deleting the audit trail.
+type MsgAcknowledgeSkippedRefund struct {
+	Sender      string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId     uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight int64  `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+// MsgAcknowledgeSkippedRefundResponse is the Msg/AcknowledgeSkippedRefund response.
+type MsgAcknowledgeSkippedRefundResponse struct{}